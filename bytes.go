@@ -0,0 +1,57 @@
+package parth
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// ByteEncoding is an encoding of a []byte within a segment. See [Encoding].
+type ByteEncoding int
+
+// ByteEncoding values.
+const (
+	EncodingRaw          ByteEncoding = iota // the segment itself
+	EncodingHex                              // hexadecimal, in either case
+	EncodingBase64                           // standard base64, with padding
+	EncodingRawBase64                        // standard base64, without padding
+	EncodingBase64URL                        // URL-safe base64, with padding
+	EncodingRawBase64URL                     // URL-safe base64, without padding
+)
+
+var byteEncodingNames = map[string]ByteEncoding{
+	"raw":          EncodingRaw,
+	"hex":          EncodingHex,
+	"base64":       EncodingBase64,
+	"rawbase64":    EncodingRawBase64,
+	"base64url":    EncodingBase64URL,
+	"rawbase64url": EncodingRawBase64URL,
+}
+
+func stringToBytes(s string, c *config) ([]byte, error) {
+	var b []byte
+	var err error
+
+	switch c.enc {
+	case EncodingHex:
+		b, err = hex.DecodeString(s)
+	case EncodingBase64:
+		b, err = base64.StdEncoding.DecodeString(s)
+	case EncodingRawBase64:
+		b, err = base64.RawStdEncoding.DecodeString(s)
+	case EncodingBase64URL:
+		b, err = base64.URLEncoding.DecodeString(s)
+	case EncodingRawBase64URL:
+		b, err = base64.RawURLEncoding.DecodeString(s)
+	default:
+		b = []byte(s)
+	}
+	if err != nil {
+		return nil, &wrapError{ErrBytesInvalid, err}
+	}
+
+	if c.byteLen > 0 && len(b) != c.byteLen {
+		return nil, ErrBytesInvalid
+	}
+
+	return b, nil
+}
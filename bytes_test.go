@@ -0,0 +1,65 @@
+package parth
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestBhvrBytes(t *testing.T) {
+	path := "/blobs/sha256/48656c6c6f/b64/SGk_/b64pad/SGk=/raw/tok"
+
+	tests := []struct {
+		name string
+		key  string
+		opts []Option
+		want []byte
+		err  error
+	}{
+		{"raw", "raw", nil, []byte("tok"), nil},
+		{"hex", "sha256", []Option{Encoding(EncodingHex)}, []byte("Hello"), nil},
+		{"hex len", "sha256", []Option{Encoding(EncodingHex), ByteLen(5)}, []byte("Hello"), nil},
+		{"hex bad len", "sha256", []Option{Encoding(EncodingHex), ByteLen(32)}, nil, ErrBytesInvalid},
+		{"hex invalid", "raw", []Option{Encoding(EncodingHex)}, nil, ErrBytesInvalid},
+		{"base64url", "b64", []Option{Encoding(EncodingRawBase64URL)}, []byte{0x48, 0x69, 0x3f}, nil},
+		{"base64url pad", "b64", []Option{Encoding(EncodingBase64URL)}, []byte{0x48, 0x69, 0x3f}, nil},
+		{"base64 url chars", "b64", []Option{Encoding(EncodingRawBase64)}, nil, ErrBytesInvalid},
+		{"base64 pad", "b64pad", []Option{Encoding(EncodingBase64)}, []byte("Hi"), nil},
+		{"base64 no pad", "b64pad", []Option{Encoding(EncodingRawBase64)}, nil, ErrBytesInvalid},
+	}
+
+	for _, tt := range tests {
+		var got []byte
+		err := Sequent(&got, path, tt.key, tt.opts...)
+		if !errors.Is(err, tt.err) {
+			t.Errorf(gwxFmt, tt.name, err, tt.err)
+			continue
+		}
+
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf(gwxFmt, tt.name, got, tt.want)
+		}
+	}
+
+	t.Run("tag", func(t *testing.T) {
+		var got struct {
+			Digest []byte `parth:"digest,enc=hex,len=5"`
+		}
+		err := SegmentScan(path, 2, "{digest}", &got)
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		if want := []byte("Hello"); !bytes.Equal(got.Digest, want) {
+			t.Errorf(gwFmt, got.Digest, want)
+		}
+
+		var bad struct {
+			Digest []byte `parth:"digest,enc=base32"`
+		}
+		err = SegmentScan(path, 2, "{digest}", &bad)
+		if !errors.Is(err, ErrTagInvalid) {
+			t.Errorf(gwFmt, err, ErrTagInvalid)
+		}
+	})
+}
@@ -0,0 +1,161 @@
+package parth
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Decimal is a fixed-point decimal number that is able to hold values such as
+// amounts of money without the loss of precision that comes with floats. The
+// value of a Decimal is Coef * 10^-Scale.
+//
+// When handling a Decimal, the first valid value within the specified segment
+// will be used (see [Strict]). The scale is limited by [MaxScale].
+type Decimal struct {
+	Coef  int64
+	Scale int
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unlike when handling a
+// segment, the text must hold only the value.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	v, err := stringToDecimal(string(text), &config{strict: true, maxScale: defaultConfig.maxScale})
+	if err != nil {
+		return err
+	}
+
+	*d = v
+
+	return nil
+}
+
+// String returns the decimal form of the Decimal (e.g. "19.99").
+func (d Decimal) String() string {
+	s := strconv.FormatInt(d.Coef, 10)
+	if d.Scale <= 0 {
+		return s + strings.Repeat("0", -d.Scale)
+	}
+
+	neg := d.Coef < 0
+	if neg {
+		s = s[1:]
+	}
+
+	if len(s) <= d.Scale {
+		s = strings.Repeat("0", d.Scale-len(s)+1) + s
+	}
+
+	s = s[:len(s)-d.Scale] + "." + s[len(s)-d.Scale:]
+	if neg {
+		s = "-" + s
+	}
+
+	return s
+}
+
+// Float64 returns the nearest float64 value of the Decimal.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+func stringToDecimal(ss string, c *config) (Decimal, error) {
+	ss, err := numericString(ss, c)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	s, ok := firstFloatFromString(ss, c.lenientSigns)
+	if !ok || c.strict && s != ss {
+		return Decimal{}, ErrDataUnparsable
+	}
+
+	return parseDecimal(s, c.maxScale)
+}
+
+// parseDecimal parses a float literal that holds only decimal digits, an
+// optional decimal point, and an optional exponent.
+func parseDecimal(s string, maxScale int) (Decimal, error) {
+	var d Decimal
+	const max = 1<<63 - 1
+
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+
+	var coef uint64
+	var dot, overflow bool
+	n := 0
+
+	for ; n < len(s); n++ {
+		c := s[n]
+
+		if c == '_' {
+			continue
+		}
+
+		if c == '.' {
+			dot = true
+			continue
+		}
+
+		if !isDecDigit(c) {
+			break
+		}
+
+		if dot {
+			d.Scale++
+		}
+
+		if coef > (max-uint64(c-'0'))/10 {
+			overflow = true
+			continue
+		}
+
+		coef = coef*10 + uint64(c-'0')
+	}
+
+	if n < len(s) {
+		if s[n] != 'e' && s[n] != 'E' {
+			return Decimal{}, ErrDataUnparsable
+		}
+
+		exp, err := strconv.Atoi(strings.ReplaceAll(s[n+1:], "_", ""))
+		if err != nil {
+			return Decimal{}, parseError(err)
+		}
+
+		d.Scale -= exp
+	}
+
+	for d.Scale > maxScale && coef%10 == 0 && coef > 0 {
+		coef /= 10
+		d.Scale--
+	}
+
+	if overflow || d.Scale > maxScale && coef > 0 {
+		return Decimal{}, ErrOutOfRange
+	}
+
+	if coef == 0 {
+		d.Scale = 0
+	}
+
+	for d.Scale < 0 {
+		if coef > max/10 {
+			return Decimal{}, ErrOutOfRange
+		}
+
+		coef *= 10
+		d.Scale++
+	}
+
+	d.Coef = int64(coef)
+	if neg {
+		d.Coef = -d.Coef
+	}
+
+	return d, nil
+}
@@ -0,0 +1,61 @@
+package parth
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUnitParseDecimal(t *testing.T) {
+	tests := []struct {
+		s        string
+		maxScale int
+		want     Decimal
+		err      error
+	}{
+		{"19.99", 18, Decimal{1999, 2}, nil},
+		{"-0.05", 18, Decimal{-5, 2}, nil},
+		{"+7", 18, Decimal{7, 0}, nil},
+		{".5", 18, Decimal{5, 1}, nil},
+		{"1_000.50", 18, Decimal{100050, 2}, nil},
+		{"1.5e2", 18, Decimal{150, 0}, nil},
+		{"15e-3", 18, Decimal{15, 3}, nil},
+		{"19.990", 2, Decimal{1999, 2}, nil},
+		{"0.000", 2, Decimal{0, 0}, nil},
+		{"19.999", 2, Decimal{}, ErrOutOfRange},
+		{"99999999999999999999", 18, Decimal{}, ErrOutOfRange},
+		{"1e30", 18, Decimal{}, ErrOutOfRange},
+		{"inf", 18, Decimal{}, ErrDataUnparsable},
+		{"0x1p2", 18, Decimal{}, ErrDataUnparsable},
+	}
+
+	for _, tt := range tests {
+		got, err := parseDecimal(tt.s, tt.maxScale)
+		if !errors.Is(err, tt.err) {
+			t.Errorf(gwxFmt, tt.s, err, tt.err)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestUnitDecimalString(t *testing.T) {
+	tests := []struct {
+		d    Decimal
+		want string
+	}{
+		{Decimal{1999, 2}, "19.99"},
+		{Decimal{-5, 2}, "-0.05"},
+		{Decimal{5, 0}, "5"},
+		{Decimal{5, -2}, "500"},
+		{Decimal{-123, 3}, "-0.123"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.d.String(); got != tt.want {
+			t.Errorf(gwxFmt, tt.d, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package parth
+
+import (
+	"strings"
+	"time"
+)
+
+var durationUnits = map[string]uint64{
+	"ns": uint64(time.Nanosecond),
+	"us": uint64(time.Microsecond),
+	"µs": uint64(time.Microsecond), // U+00B5 = micro symbol
+	"μs": uint64(time.Microsecond), // U+03BC = Greek letter mu
+	"ms": uint64(time.Millisecond),
+	"s":  uint64(time.Second),
+	"m":  uint64(time.Minute),
+	"h":  uint64(time.Hour),
+	"d":  uint64(24 * time.Hour),
+	"w":  uint64(7 * 24 * time.Hour),
+}
+
+// parseExtDuration parses a duration as [time.ParseDuration] does, but also
+// accepts the units "d" (24 hours) and "w" (7 days), as well as ISO 8601
+// durations (e.g. "P1DT2H").
+func parseExtDuration(s string) (time.Duration, error) {
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+
+	if s == "0" {
+		return 0, nil
+	}
+
+	if s == "" {
+		return 0, ErrDataUnparsable
+	}
+
+	var d uint64
+	var err error
+	if s[0] == 'P' || s[0] == 'p' {
+		d, err = parseISODuration(s[1:])
+	} else {
+		d, err = parseUnitDuration(s)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if neg {
+		return -time.Duration(d), nil
+	}
+
+	if d > 1<<63-1 {
+		return 0, ErrOutOfRange
+	}
+
+	return time.Duration(d), nil
+}
+
+func parseUnitDuration(s string) (uint64, error) {
+	var d uint64
+
+	for s != "" {
+		v, frac, scale, rest, ok := leadingDecimal(s, '.')
+		if !ok {
+			return 0, ErrDataUnparsable
+		}
+		s = rest
+
+		n := 0
+		for n < len(s) && s[n] != '.' && !isDecDigit(s[n]) {
+			n++
+		}
+
+		u := s[:n]
+		s = s[n:]
+
+		unit, ok := durationUnits[u]
+		if !ok {
+			if isAmbiguousUnit(u) {
+				return 0, ErrDurationAmbiguous
+			}
+
+			return 0, ErrDataUnparsable
+		}
+
+		if d, ok = addDuration(d, v, frac, scale, unit); !ok {
+			return 0, ErrOutOfRange
+		}
+	}
+
+	return d, nil
+}
+
+func isAmbiguousUnit(u string) bool {
+	switch strings.ToLower(u) {
+	case "mo", "mon", "month", "months", "y", "yr", "yrs", "year", "years":
+		return true
+	}
+
+	return false
+}
+
+// parseISODuration parses an ISO 8601 duration that has had the leading "P"
+// sliced off.
+func parseISODuration(s string) (uint64, error) {
+	var d uint64
+	var ct int
+
+	const dateUnits, timeUnits = "YMWD", "HMS"
+	units, last := dateUnits, -1
+
+	for s != "" {
+		if s[0] == 'T' || s[0] == 't' {
+			if units == timeUnits || len(s) == 1 {
+				return 0, ErrDataUnparsable
+			}
+
+			units, last = timeUnits, -1
+			s = s[1:]
+			continue
+		}
+
+		sep := byte('.')
+		if strings.IndexByte(s, ',') >= 0 {
+			sep = ','
+		}
+
+		v, frac, scale, rest, ok := leadingDecimal(s, sep)
+		if !ok || rest == "" {
+			return 0, ErrDataUnparsable
+		}
+
+		n := strings.IndexByte(units, upper(rest[0]))
+		if n <= last {
+			return 0, ErrDataUnparsable
+		}
+		last = n
+		s = rest[1:]
+
+		var unit uint64
+		switch units[n] {
+		case 'Y':
+			return 0, ErrDurationAmbiguous
+		case 'M':
+			if units == dateUnits {
+				return 0, ErrDurationAmbiguous
+			}
+			unit = uint64(time.Minute)
+		case 'W':
+			unit = uint64(7 * 24 * time.Hour)
+		case 'D':
+			unit = uint64(24 * time.Hour)
+		case 'H':
+			unit = uint64(time.Hour)
+		case 'S':
+			unit = uint64(time.Second)
+		}
+
+		if d, ok = addDuration(d, v, frac, scale, unit); !ok {
+			return 0, ErrOutOfRange
+		}
+		ct++
+	}
+
+	if ct == 0 {
+		return 0, ErrDataUnparsable
+	}
+
+	return d, nil
+}
+
+// leadingDecimal consumes a decimal number (with an optional fraction that
+// follows sep) from the start of s. The fraction is returned as frac/scale.
+func leadingDecimal(s string, sep byte) (v, frac, scale uint64, rest string, ok bool) {
+	n, ds := 0, 0
+
+	for ; n < len(s) && isDecDigit(s[n]); n++ {
+		ds++
+		if v > 1<<63/10 {
+			v = 1<<63 + 1 // overflows any unit
+			continue
+		}
+		v = v*10 + uint64(s[n]-'0')
+	}
+
+	scale = 1
+	if n < len(s) && s[n] == sep {
+		n++
+
+		for ; n < len(s) && isDecDigit(s[n]); n++ {
+			ds++
+			if scale > (1<<63-1)/10 {
+				continue
+			}
+			frac = frac*10 + uint64(s[n]-'0')
+			scale *= 10
+		}
+	}
+
+	return v, frac, scale, s[n:], ds > 0
+}
+
+// addDuration adds (v + frac/scale) * unit to d, and reports false if the
+// result overflows.
+func addDuration(d, v, frac, scale, unit uint64) (uint64, bool) {
+	const max = 1 << 63
+
+	if v > max/unit {
+		return 0, false
+	}
+	v *= unit
+
+	if frac > 0 {
+		v += uint64(float64(frac) * (float64(unit) / float64(scale)))
+		if v > max {
+			return 0, false
+		}
+	}
+
+	d += v
+	if d > max {
+		return 0, false
+	}
+
+	return d, true
+}
+
+func upper(c byte) byte {
+	if 'a' <= c && c <= 'z' {
+		return c - ('a' - 'A')
+	}
+
+	return c
+}
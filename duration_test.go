@@ -0,0 +1,52 @@
+package parth
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestUnitParseExtDuration(t *testing.T) {
+	tests := []struct {
+		s    string
+		want time.Duration
+		err  error
+	}{
+		{"7d", 7 * 24 * time.Hour, nil},
+		{"1w2d12h", 9*24*time.Hour + 12*time.Hour, nil},
+		{"1.5d", 36 * time.Hour, nil},
+		{"-2h30m", -2*time.Hour - 30*time.Minute, nil},
+		{"300ms", 300 * time.Millisecond, nil},
+		{"0", 0, nil},
+		{"P1DT2H", 26 * time.Hour, nil},
+		{"P2W", 14 * 24 * time.Hour, nil},
+		{"PT30M", 30 * time.Minute, nil},
+		{"PT1,5S", 1500 * time.Millisecond, nil},
+		{"-P1D", -24 * time.Hour, nil},
+		{"P1M", 0, ErrDurationAmbiguous},
+		{"P1Y2D", 0, ErrDurationAmbiguous},
+		{"3mo", 0, ErrDurationAmbiguous},
+		{"1y", 0, ErrDurationAmbiguous},
+		{"200000w", 0, ErrOutOfRange},
+		{"99999999999999999999s", 0, ErrOutOfRange},
+		{"P", 0, ErrDataUnparsable},
+		{"P1DT", 0, ErrDataUnparsable},
+		{"PT1H1D", 0, ErrDataUnparsable},
+		{"P1H", 0, ErrDataUnparsable},
+		{"5", 0, ErrDataUnparsable},
+		{"d", 0, ErrDataUnparsable},
+		{"", 0, ErrDataUnparsable},
+	}
+
+	for _, tt := range tests {
+		got, err := parseExtDuration(tt.s)
+		if !errors.Is(err, tt.err) {
+			t.Errorf(gwxFmt, tt.s, err, tt.err)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.s, got, tt.want)
+		}
+	}
+}
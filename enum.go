@@ -0,0 +1,67 @@
+package parth
+
+import (
+	"sort"
+	"strings"
+)
+
+// Enum is a destination that accepts only the values within a set. An Enum is
+// created by [OneOf] or [OneOfMap], and is case-sensitive unless [FoldCase]
+// is provided. A value that is not accepted results in an [*EnumError].
+type Enum struct {
+	vals []string
+	set  func(n int)
+}
+
+// OneOf returns an [Enum] that accepts only the provided values. The accepted
+// value is stored in dst, which may be of any string type (e.g. a type with
+// constants such as "csv" and "json"). When [FoldCase] is provided, the value
+// is stored as it is written in vals rather than as it is in the segment.
+func OneOf[T ~string](dst *T, vals ...T) *Enum {
+	e := &Enum{vals: make([]string, len(vals))}
+	for n, v := range vals {
+		e.vals[n] = string(v)
+	}
+
+	e.set = func(n int) {
+		*dst = vals[n]
+	}
+
+	return e
+}
+
+// OneOfMap returns an [Enum] that accepts only the keys of m. The value that
+// corresponds to the accepted key is stored in dst.
+func OneOfMap[T any](dst *T, m map[string]T) *Enum {
+	e := &Enum{vals: make([]string, 0, len(m))}
+	for k := range m {
+		e.vals = append(e.vals, k)
+	}
+	sort.Strings(e.vals)
+
+	e.set = func(n int) {
+		*dst = m[e.vals[n]]
+	}
+
+	return e
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text is matched
+// case-sensitively.
+func (e *Enum) UnmarshalText(text []byte) error {
+	return e.unmarshal(string(text), false)
+}
+
+func (e *Enum) unmarshal(s string, fold bool) error {
+	for n, v := range e.vals {
+		if v == s || fold && strings.EqualFold(v, s) {
+			e.set(n)
+			return nil
+		}
+	}
+
+	return &EnumError{
+		Value:    s,
+		Accepted: append([]string(nil), e.vals...),
+	}
+}
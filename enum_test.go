@@ -0,0 +1,97 @@
+package parth
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type format string
+
+const (
+	formatCSV  format = "csv"
+	formatJSON format = "json"
+)
+
+func TestBhvrEnum(t *testing.T) {
+	path := "/reports/JSON/level/warn"
+
+	t.Run("oneof", func(t *testing.T) {
+		var got format
+		err := Segment(OneOf(&got, formatCSV, formatJSON), path, 1, FoldCase())
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		if got != formatJSON {
+			t.Errorf(gwFmt, got, formatJSON)
+		}
+	})
+
+	t.Run("case", func(t *testing.T) {
+		var got format
+		err := Segment(OneOf(&got, formatCSV, formatJSON), path, 1)
+
+		var eerr *EnumError
+		if !errors.As(err, &eerr) || !errors.Is(err, ErrDataUnparsable) {
+			t.Fatalf(gwFmt, err, "{*EnumError}")
+		}
+
+		want := &EnumError{Value: "JSON", Accepted: []string{"csv", "json"}}
+		if !reflect.DeepEqual(eerr, want) {
+			t.Errorf(gwFmt, eerr, want)
+		}
+
+		wantMsg := `data cannot be parsed: "JSON" is not one of "csv", "json"`
+		if err.Error() != wantMsg {
+			t.Errorf(gwFmt, err.Error(), wantMsg)
+		}
+	})
+
+	t.Run("map", func(t *testing.T) {
+		levels := map[string]int{"debug": -4, "info": 0, "warn": 4}
+
+		got := -1
+		err := Sequent(OneOfMap(&got, levels), path, "level")
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		if got != 4 {
+			t.Errorf(gwFmt, got, 4)
+		}
+
+		var eerr *EnumError
+		err = Sequent(OneOfMap(&got, levels), path, "reports")
+		if !errors.As(err, &eerr) {
+			t.Fatalf(gwFmt, err, "{*EnumError}")
+		}
+
+		want := []string{"debug", "info", "warn"}
+		if !reflect.DeepEqual(eerr.Accepted, want) {
+			t.Errorf(gwFmt, eerr.Accepted, want)
+		}
+	})
+
+	t.Run("tag", func(t *testing.T) {
+		var got struct {
+			Format format `parth:"format,oneof=csv|json,fold"`
+		}
+		err := SegmentScan(path, 1, "{format}", &got)
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		if got.Format != formatJSON {
+			t.Errorf(gwFmt, got.Format, formatJSON)
+		}
+
+		var bad struct {
+			Format int `parth:"format,oneof=1|2"`
+		}
+		err = SegmentScan("/1", 0, "{format}", &bad)
+		if !errors.Is(err, ErrTagInvalid) {
+			t.Errorf(gwFmt, err, ErrTagInvalid)
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package parth
+
+import (
+	"strconv"
+	"strings"
+)
+
+// wrapError associates an underlying error with one of the Err{Name} values so
+// that both remain identifiable.
+type wrapError struct {
+	kind error
+	err  error
+}
+
+func (e *wrapError) Error() string {
+	return e.kind.Error() + ": " + e.err.Error()
+}
+
+func (e *wrapError) Is(target error) bool {
+	return target == e.kind
+}
+
+func (e *wrapError) Unwrap() error {
+	return e.err
+}
+
+// EnumError is returned when a value is not accepted by an [Enum]. It is
+// identifiable as [ErrDataUnparsable].
+type EnumError struct {
+	Value    string
+	Accepted []string
+}
+
+func (e *EnumError) Error() string {
+	var b strings.Builder
+
+	b.WriteString(ErrDataUnparsable.Error())
+	b.WriteString(": ")
+	b.WriteString(strconv.Quote(e.Value))
+	b.WriteString(" is not one of ")
+
+	for n, v := range e.Accepted {
+		if n > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(strconv.Quote(v))
+	}
+
+	return b.String()
+}
+
+// Is reports whether target is [ErrDataUnparsable].
+func (e *EnumError) Is(target error) bool {
+	return target == ErrDataUnparsable
+}
@@ -33,6 +33,48 @@ func ExampleSegment() {
 	// nn4.4nn (string)
 }
 
+func ExampleSegmentNumber() {
+	var height int
+	if err := parth.SegmentNumber(&height, "/img/800x600", 1, 1); err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Printf("%[1]v (%[1]T)\n", height)
+
+	// Output:
+	// 600 (int)
+}
+
+func ExampleSegmentNumbers() {
+	nums, err := parth.SegmentNumbers("/api/v2.14.3", 1)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(nums)
+
+	// Output:
+	// [2 14 3]
+}
+
+func ExampleSegmentScan() {
+	var thumb struct {
+		W, H  int
+		Scale int
+		Ext   string
+	}
+
+	pattern := "{w:int}x{h:int}@{scale:int}x.{ext}"
+	if err := parth.SegmentScan("/img/800x600@2x.webp", 1, pattern, &thumb); err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Printf("%+v\n", thumb)
+
+	// Output:
+	// {W:800 H:600 Scale:2 Ext:webp}
+}
+
 func ExampleSequent() {
 	var afterKey float32
 	if err := parth.Sequent(&afterKey, req.URL.Path, "key"); err != nil {
@@ -107,6 +149,18 @@ func ExampleParth() {
 	// 5.5 (float32)
 }
 
+func ExampleBase() {
+	var reg uint16
+	if err := parth.Segment(&reg, "/registers/1f/bits", 1, parth.Base(16)); err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Printf("%[1]v (%[1]T)\n", reg)
+
+	// Output:
+	// 31 (uint16)
+}
+
 type MyType []byte
 
 // UnmarshalText implements encoding.TextUnmarshaler. Let's pretend something
@@ -127,3 +181,31 @@ func Example_encodingTextUnmarshaler() {
 	// Output:
 	// [110 110 52 46 52 110 110] == "nn4.4nn" (parth_test.MyType)
 }
+
+func ExampleOneOf() {
+	type format string
+
+	var f format
+	err := parth.Segment(parth.OneOf(&f, "csv", "json"), "/reports/pdf", 1)
+	fmt.Println(f == "", err)
+
+	err = parth.Segment(parth.OneOf(&f, "csv", "json"), "/reports/JSON", 1, parth.FoldCase())
+	fmt.Println(f, err)
+
+	// Output:
+	// true data cannot be parsed: "pdf" is not one of "csv", "json"
+	// json <nil>
+}
+
+func ExampleSegmentExt() {
+	var id int
+	ext, err := parth.SegmentExt(&id, "/users/42.json", 1)
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	fmt.Println(id, ext)
+
+	// Output:
+	// 42 json
+}
@@ -0,0 +1,100 @@
+package parth
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Tile is a map tile address, as used by "slippy map" tile servers (e.g. the
+// path "/tiles/12/1205/1539.png" holds the tile 12/1205/1539 at index 1). X
+// and Y must each be less than 2^Z, and Z must not exceed 30. See [SpanTile].
+type Tile struct {
+	Z uint32
+	X uint32
+	Y uint32
+}
+
+// String returns the tile address in the form "z/x/y".
+func (t Tile) String() string {
+	return strconv.FormatUint(uint64(t.Z), 10) + "/" + strconv.FormatUint(uint64(t.X), 10) +
+		"/" + strconv.FormatUint(uint64(t.Y), 10)
+}
+
+// spanToTile converts a span of three segments (without a leading slash) into
+// a Tile. The last segment may hold a suffix that follows the y value (e.g.
+// ".png", or "@2x.png").
+func spanToTile(s string) (Tile, error) {
+	zs, s, _ := strings.Cut(s, "/")
+	xs, ys, _ := strings.Cut(s, "/")
+
+	if l := intLen(ys, 10); l > 0 && l < len(ys) {
+		if ys[l] != '.' && ys[l] != '@' {
+			return Tile{}, ErrDataUnparsable
+		}
+		ys = ys[:l]
+	}
+
+	var vs [3]uint64
+	for n, s := range [3]string{zs, xs, ys} {
+		if s == "" || intLen(s, 10) != len(s) {
+			return Tile{}, ErrDataUnparsable
+		}
+
+		v, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return Tile{}, parseError(err)
+		}
+		vs[n] = v
+	}
+
+	z, x, y := vs[0], vs[1], vs[2]
+	if z > 30 || x >= 1<<z || y >= 1<<z {
+		return Tile{}, ErrOutOfRange
+	}
+
+	return Tile{Z: uint32(z), X: uint32(x), Y: uint32(y)}, nil
+}
+
+// LatLng is a geographic coordinate. It can be unmarshaled from a latitude and
+// longitude in decimal degrees that are separated by a comma (e.g.
+// "40.6892,-74.0445"). The latitude must be within [-90, 90], and the
+// longitude must be within [-180, 180], otherwise [ErrOutOfRange] is returned.
+type LatLng struct {
+	Lat float64
+	Lng float64
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (ll *LatLng) UnmarshalText(text []byte) error {
+	lat, lng, ok := strings.Cut(string(text), ",")
+	if !ok {
+		return ErrDataUnparsable
+	}
+
+	var vs [2]float64
+	for n, s := range [2]string{lat, lng} {
+		if s == "" || floatLen(s) != len(s) {
+			return ErrDataUnparsable
+		}
+
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil || math.IsInf(v, 0) || math.IsNaN(v) {
+			return ErrDataUnparsable
+		}
+		vs[n] = v
+	}
+
+	if math.Abs(vs[0]) > 90 || math.Abs(vs[1]) > 180 {
+		return ErrOutOfRange
+	}
+
+	ll.Lat, ll.Lng = vs[0], vs[1]
+
+	return nil
+}
+
+// String returns the coordinate in the form "lat,lng".
+func (ll LatLng) String() string {
+	return strconv.FormatFloat(ll.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(ll.Lng, 'f', -1, 64)
+}
@@ -0,0 +1,68 @@
+package parth
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUnitSpanToTile(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Tile
+		err  error
+	}{
+		{"12/1205/1539.png", Tile{12, 1205, 1539}, nil},
+		{"12/1205/1539@2x.png", Tile{12, 1205, 1539}, nil},
+		{"0/0/0", Tile{}, nil},
+		{"2/3/3", Tile{2, 3, 3}, nil},
+		{"2/4/3", Tile{}, ErrOutOfRange},
+		{"2/3/4.png", Tile{}, ErrOutOfRange},
+		{"31/0/0", Tile{}, ErrOutOfRange},
+		{"2/-1/0", Tile{}, ErrDataUnparsable},
+		{"2/1/1png", Tile{}, ErrDataUnparsable},
+		{"2/1/.png", Tile{}, ErrDataUnparsable},
+		{"2/1", Tile{}, ErrDataUnparsable},
+	}
+
+	for _, tt := range tests {
+		got, err := spanToTile(tt.s)
+		if !errors.Is(err, tt.err) {
+			t.Errorf(gwxFmt, tt.s, err, tt.err)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestUnitLatLng(t *testing.T) {
+	tests := []struct {
+		s    string
+		want LatLng
+		err  error
+	}{
+		{"40.6892,-74.0445", LatLng{40.6892, -74.0445}, nil},
+		{"-90,180", LatLng{-90, 180}, nil},
+		{"90.1,0", LatLng{}, ErrOutOfRange},
+		{"0,-180.5", LatLng{}, ErrOutOfRange},
+		{"inf,0", LatLng{}, ErrDataUnparsable},
+		{"1,2,3", LatLng{}, ErrDataUnparsable},
+		{"40.6892", LatLng{}, ErrDataUnparsable},
+		{"n40,w74", LatLng{}, ErrDataUnparsable},
+	}
+
+	for _, tt := range tests {
+		var got LatLng
+		err := got.UnmarshalText([]byte(tt.s))
+		if !errors.Is(err, tt.err) {
+			t.Errorf(gwxFmt, tt.s, err, tt.err)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.s, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package parth
+
+import (
+	"encoding/hex"
+	"time"
+)
+
+// UUID is a 16-byte universally unique identifier. It can be unmarshaled from
+// the canonical textual form (e.g. "123e4567-e89b-12d3-a456-426614174000") in
+// either lowercase or uppercase, but not a mix of the two.
+type UUID [16]byte
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (u *UUID) UnmarshalText(text []byte) error {
+	if !validUUID(text) {
+		return ErrDataUnparsable
+	}
+
+	var b [32]byte
+	n := 0
+	for _, c := range text {
+		if c != '-' {
+			b[n] = c
+			n++
+		}
+	}
+
+	if _, err := hex.Decode(u[:], b[:]); err != nil {
+		return ErrDataUnparsable
+	}
+
+	return nil
+}
+
+// String returns the canonical, lowercase form of the UUID.
+func (u UUID) String() string {
+	var b [36]byte
+
+	hex.Encode(b[0:8], u[0:4])
+	b[8] = '-'
+	hex.Encode(b[9:13], u[4:6])
+	b[13] = '-'
+	hex.Encode(b[14:18], u[6:8])
+	b[18] = '-'
+	hex.Encode(b[19:23], u[8:10])
+	b[23] = '-'
+	hex.Encode(b[24:], u[10:])
+
+	return string(b[:])
+}
+
+func validUUID(text []byte) bool {
+	if len(text) != 36 {
+		return false
+	}
+
+	var lower, upper bool
+	for n, c := range text {
+		switch n {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+			continue
+		}
+
+		switch {
+		case isDecDigit(c):
+		case 'a' <= c && c <= 'f':
+			lower = true
+		case 'A' <= c && c <= 'F':
+			upper = true
+		default:
+			return false
+		}
+	}
+
+	return !(lower && upper)
+}
+
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ULID is a 16-byte universally unique lexicographically sortable identifier.
+// It can be unmarshaled from its 26 character Crockford base32 form (e.g.
+// "01ARZ3NDEKTSV4RRFFQ69G5FAV") in either lowercase or uppercase, but not a mix
+// of the two.
+type ULID [16]byte
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (u *ULID) UnmarshalText(text []byte) error {
+	if len(text) != 26 || text[0] > '7' {
+		return ErrDataUnparsable
+	}
+
+	var v ULID
+	var lower, upper bool
+	for _, c := range text {
+		d := crockfordDigit(c)
+		if d < 0 {
+			return ErrDataUnparsable
+		}
+
+		lower = lower || 'a' <= c && c <= 'z'
+		upper = upper || 'A' <= c && c <= 'Z'
+		if lower && upper {
+			return ErrDataUnparsable
+		}
+
+		// shift the 128-bit value left by 5 bits, then add the digit
+		for n := 0; n < len(v); n++ {
+			v[n] <<= 5
+			if n+1 < len(v) {
+				v[n] |= v[n+1] >> 3
+			}
+		}
+		v[len(v)-1] |= byte(d)
+	}
+
+	*u = v
+
+	return nil
+}
+
+// String returns the uppercase Crockford base32 form of the ULID.
+func (u ULID) String() string {
+	var b [26]byte
+
+	v := u
+	for n := len(b) - 1; n >= 0; n-- {
+		b[n] = crockford[v[len(v)-1]&0x1f]
+
+		// shift the 128-bit value right by 5 bits
+		for m := len(v) - 1; m >= 0; m-- {
+			v[m] >>= 5
+			if m > 0 {
+				v[m] |= v[m-1] << 3
+			}
+		}
+	}
+
+	return string(b[:])
+}
+
+// Time returns the timestamp held by the ULID.
+func (u ULID) Time() time.Time {
+	var ms int64
+	for _, b := range u[:6] {
+		ms = ms<<8 | int64(b)
+	}
+
+	return time.UnixMilli(ms).UTC()
+}
+
+func crockfordDigit(c byte) int {
+	if 'a' <= c && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+
+	for n := 0; n < len(crockford); n++ {
+		if crockford[n] == c {
+			return n
+		}
+	}
+
+	return -1
+}
+
+const base62 = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// KSUID is a 20-byte K-sortable unique identifier. It can be unmarshaled from
+// its 27 character base62 form (e.g. "0ujtsYcgvSTl8PAuAdqWYSMnLOv"). Base62 is
+// case-sensitive.
+type KSUID [20]byte
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (k *KSUID) UnmarshalText(text []byte) error {
+	if len(text) != 27 {
+		return ErrDataUnparsable
+	}
+
+	var v KSUID
+	for _, c := range text {
+		d := base62Digit(c)
+		if d < 0 {
+			return ErrDataUnparsable
+		}
+
+		// multiply the 160-bit value by 62, then add the digit
+		carry := uint(d)
+		for n := len(v) - 1; n >= 0; n-- {
+			x := uint(v[n])*62 + carry
+			v[n] = byte(x)
+			carry = x >> 8
+		}
+
+		if carry > 0 {
+			return ErrDataUnparsable
+		}
+	}
+
+	*k = v
+
+	return nil
+}
+
+// String returns the base62 form of the KSUID.
+func (k KSUID) String() string {
+	var b [27]byte
+
+	v := k
+	for n := len(b) - 1; n >= 0; n-- {
+		// divide the 160-bit value by 62, keeping the remainder
+		var rem uint
+		for m := 0; m < len(v); m++ {
+			x := rem<<8 | uint(v[m])
+			v[m] = byte(x / 62)
+			rem = x % 62
+		}
+
+		b[n] = base62[rem]
+	}
+
+	return string(b[:])
+}
+
+// Time returns the timestamp held by the KSUID.
+func (k KSUID) Time() time.Time {
+	const epoch = 1400000000
+
+	var s int64
+	for _, b := range k[:4] {
+		s = s<<8 | int64(b)
+	}
+
+	return time.Unix(s+epoch, 0).UTC()
+}
+
+// Base62 is a short identifier made up of only base62 characters (0-9, A-Z,
+// and a-z), as is common for URL shorteners. Base62 is case-sensitive.
+type Base62 string
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *Base62) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		return ErrDataUnparsable
+	}
+
+	for _, c := range text {
+		if base62Digit(c) < 0 {
+			return ErrDataUnparsable
+		}
+	}
+
+	*b = Base62(text)
+
+	return nil
+}
+
+// Uint64 returns the value of the identifier when it is handled as a base62
+// number. [ErrOutOfRange] is returned if the value overflows a uint64.
+func (b Base62) Uint64() (uint64, error) {
+	if len(b) == 0 {
+		return 0, ErrDataUnparsable
+	}
+
+	var v uint64
+	for n := 0; n < len(b); n++ {
+		d := base62Digit(b[n])
+		if d < 0 {
+			return 0, ErrDataUnparsable
+		}
+
+		if v > (1<<64-1-uint64(d))/62 {
+			return 0, ErrOutOfRange
+		}
+
+		v = v*62 + uint64(d)
+	}
+
+	return v, nil
+}
+
+func base62Digit(c byte) int {
+	switch {
+	case isDecDigit(c):
+		return int(c - '0')
+	case 'A' <= c && c <= 'Z':
+		return int(c-'A') + 10
+	case 'a' <= c && c <= 'z':
+		return int(c-'a') + 36
+	}
+
+	return -1
+}
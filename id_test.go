@@ -0,0 +1,156 @@
+package parth
+
+import "testing"
+
+func TestUnitUUID(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+		ck   checkFunc
+	}{
+		{"123e4567-e89b-12d3-a456-426614174000", "123e4567-e89b-12d3-a456-426614174000", unx},
+		{"123E4567-E89B-12D3-A456-426614174000", "123e4567-e89b-12d3-a456-426614174000", unx},
+		{"00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000", unx},
+		{"123E4567-e89b-12d3-a456-426614174000", "", exp},
+		{"123e4567e89b12d3a456426614174000", "", exp},
+		{"123e4567-e89b-12d3-a456-42661417400g", "", exp},
+		{"123e4567-e89b-12d3-a456-4266141740001", "", exp},
+	}
+
+	for _, tt := range tests {
+		var got UUID
+		err := got.UnmarshalText([]byte(tt.s))
+		if tt.ck(t, tt.s, err) || err != nil {
+			continue
+		}
+
+		if got.String() != tt.want {
+			t.Errorf(gwxFmt, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestUnitULID(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+		ck   checkFunc
+	}{
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAV", "01ARZ3NDEKTSV4RRFFQ69G5FAV", unx},
+		{"01arz3ndektsv4rrffq69g5fav", "01ARZ3NDEKTSV4RRFFQ69G5FAV", unx},
+		{"01ARZ3NDEKTSV4RRFFQ69G5fav", "", exp},
+		{"7ZZZZZZZZZZZZZZZZZZZZZZZZZ", "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", unx},
+		{"8ZZZZZZZZZZZZZZZZZZZZZZZZZ", "", exp},
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAU", "", exp},
+		{"01ARZ3NDEKTSV4RRFFQ69G5FA", "", exp},
+	}
+
+	for _, tt := range tests {
+		var got ULID
+		err := got.UnmarshalText([]byte(tt.s))
+		if tt.ck(t, tt.s, err) || err != nil {
+			continue
+		}
+
+		if got.String() != tt.want {
+			t.Errorf(gwxFmt, tt.s, got, tt.want)
+		}
+	}
+
+	var u ULID
+	_ = u.UnmarshalText([]byte("01ARYZ6S410000000000000000"))
+	if got, want := u.Time().UnixMilli(), int64(1469918176385); got != want {
+		t.Errorf(gwFmt, got, want)
+	}
+}
+
+func TestUnitKSUID(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+		ck   checkFunc
+	}{
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLOv", "0ujtsYcgvSTl8PAuAdqWYSMnLOv", unx},
+		{"aWgEPTl1tmebfsQzFP4bxwgy80V", "aWgEPTl1tmebfsQzFP4bxwgy80V", unx},
+		{"000000000000000000000000000", "000000000000000000000000000", unx},
+		{"aWgEPTl1tmebfsQzFP4bxwgy80W", "", exp},
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLO-", "", exp},
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLO", "", exp},
+	}
+
+	for _, tt := range tests {
+		var got KSUID
+		err := got.UnmarshalText([]byte(tt.s))
+		if tt.ck(t, tt.s, err) || err != nil {
+			continue
+		}
+
+		if got.String() != tt.want {
+			t.Errorf(gwxFmt, tt.s, got, tt.want)
+		}
+	}
+
+	var k KSUID
+	_ = k.UnmarshalText([]byte("0ujtsYcgvSTl8PAuAdqWYSMnLOv"))
+	if got, want := k.Time().Unix(), int64(1507608047); got != want {
+		t.Errorf(gwFmt, got, want)
+	}
+}
+
+func TestUnitBase62(t *testing.T) {
+	tests := []struct {
+		s    string
+		want uint64
+		ck   checkFunc
+	}{
+		{"0", 0, unx},
+		{"z", 61, unx},
+		{"10", 62, unx},
+		{"LygHa16AHYF", 1<<64 - 1, unx},
+		{"LygHa16AHYG", 0, exp},
+		{"ab_c", 0, exp},
+		{"", 0, exp},
+	}
+
+	for _, tt := range tests {
+		got, err := Base62(tt.s).Uint64()
+		if tt.ck(t, tt.s, err) {
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestUnitBase62UnmarshalText(t *testing.T) {
+	var b Base62
+	unx(t, "valid", b.UnmarshalText([]byte("aZ09")))
+	exp(t, "invalid", b.UnmarshalText([]byte("ab_c")))
+	exp(t, "empty", b.UnmarshalText(nil))
+}
+
+func TestBhvrIdentifiers(t *testing.T) {
+	path := "/users/123e4567-e89b-12d3-a456-426614174000/orders/01ARZ3NDEKTSV4RRFFQ69G5FAV/bad/123e4567"
+
+	var u UUID
+	if err := Segment(&u, path, 1); !unx(t, "uuid", err) && u.String() != "123e4567-e89b-12d3-a456-426614174000" {
+		t.Errorf(gwFmt, u, "123e4567-e89b-12d3-a456-426614174000")
+	}
+
+	var l ULID
+	if err := Sequent(&l, path, "orders"); !unx(t, "ulid", err) && l.String() != "01ARZ3NDEKTSV4RRFFQ69G5FAV" {
+		t.Errorf(gwFmt, l, "01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	}
+
+	exp(t, "bad uuid", Sequent(&u, path, "bad"))
+
+	var scanned struct{ ID string }
+	if err := SegmentScan(path, 1, "{id:uuid}", &scanned); !unx(t, "scan", err) && scanned.ID != "123e4567-e89b-12d3-a456-426614174000" {
+		t.Errorf(gwFmt, scanned.ID, "123e4567-e89b-12d3-a456-426614174000")
+	}
+
+	exp(t, "bad scan", SegmentScan(path, -1, "{id:uuid}", &scanned))
+	exp(t, "bad scan ulid", SegmentScan(path, 1, "{id:ulid}", &scanned))
+}
@@ -0,0 +1,40 @@
+package parth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+)
+
+// JSONValue is a destination that holds base64url-encoded JSON (with or
+// without padding). It is created by [JSON].
+type JSONValue struct {
+	v any
+}
+
+// JSON returns a [JSONValue] that decodes the segment as base64url, and then
+// unmarshals the result into v using [json.Unmarshal]. The size of the decoded
+// JSON is limited by [MaxJSONSize]. Invalid base64url or JSON results in
+// [ErrDataUnparsable], which wraps the underlying error.
+func JSON(v any) *JSONValue {
+	return &JSONValue{v: v}
+}
+
+func (j *JSONValue) unmarshal(s string, c *config) error {
+	s = strings.TrimRight(s, "=")
+
+	if base64.RawURLEncoding.DecodedLen(len(s)) > c.maxJSON {
+		return ErrOutOfRange
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return &wrapError{ErrDataUnparsable, err}
+	}
+
+	if err := json.Unmarshal(b, j.v); err != nil {
+		return &wrapError{ErrDataUnparsable, err}
+	}
+
+	return nil
+}
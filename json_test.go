@@ -0,0 +1,57 @@
+package parth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type filter struct {
+	Status []string `json:"status"`
+	Limit  int      `json:"limit"`
+}
+
+func TestBhvrJSON(t *testing.T) {
+	enc := base64.RawURLEncoding.EncodeToString([]byte(`{"status":["open","held"],"limit":20}`))
+	pad := base64.URLEncoding.EncodeToString([]byte(`{"limit":5}`))
+	bad := base64.RawURLEncoding.EncodeToString([]byte(`{"limit":"x"}`))
+	path := "/rpc/list/" + enc + "/pad/" + pad + "/bad/" + bad + "/b64/a+b/"
+
+	tests := []struct {
+		name string
+		key  string
+		opts []Option
+		want filter
+		err  error
+	}{
+		{"basic", "list", nil, filter{[]string{"open", "held"}, 20}, nil},
+		{"padded", "pad", nil, filter{Limit: 5}, nil},
+		{"limit", "list", []Option{MaxJSONSize(16)}, filter{}, ErrOutOfRange},
+		{"json", "bad", nil, filter{}, ErrDataUnparsable},
+		{"base64", "b64", nil, filter{}, ErrDataUnparsable},
+	}
+
+	for _, tt := range tests {
+		var got filter
+		err := Sequent(JSON(&got), path, tt.key, tt.opts...)
+		if !errors.Is(err, tt.err) {
+			t.Errorf(gwxFmt, tt.name, err, tt.err)
+			continue
+		}
+
+		if !reflect.DeepEqual(got, tt.want) && tt.err == nil {
+			t.Errorf(gwxFmt, tt.name, got, tt.want)
+		}
+	}
+
+	var got filter
+	err := Segment(JSON(&got), path, 6)
+
+	var jerr *json.UnmarshalTypeError
+	if !errors.As(err, &jerr) || !strings.HasPrefix(err.Error(), ErrDataUnparsable.Error()) {
+		t.Errorf(gwFmt, err, "{*json.UnmarshalTypeError}")
+	}
+}
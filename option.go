@@ -0,0 +1,277 @@
+package parth
+
+import (
+	"strings"
+	"time"
+)
+
+// Option modifies the default behavior of functions that unmarshal segments
+// (e.g. [Segment], [SubSeg]) or locate them by key (e.g. [SubSpan]). Options
+// that do not apply to the provided value type are ignored. Some options are
+// also available as struct tag options for use with [SegmentScan] (e.g.
+// `parth:"name,layout=2006-01-02"`), and are noted as such.
+type Option func(*config)
+
+type config struct {
+	base     int
+	saturate bool
+	layouts  []string
+	loc      *time.Location
+	extDur   bool
+	strict   bool
+	maxScale int
+	fold     bool
+
+	trueWords, falseWords []string
+
+	units map[string]uint64
+
+	enc     ByteEncoding
+	byteLen int
+
+	maxJSON int
+
+	prefix, suffix string
+
+	lenientSigns bool
+	normDigits   bool
+
+	keyFold    func(string, string) bool
+	keyAliases []string
+}
+
+var defaultConfig = config{
+	layouts:  []string{time.RFC3339, "2006-01-02", LayoutUnix},
+	loc:      time.UTC,
+	maxScale: 18,
+	maxJSON:  4096,
+}
+
+func makeConfig(opts []Option) *config {
+	if len(opts) == 0 {
+		return &defaultConfig
+	}
+
+	c := defaultConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return &c
+}
+
+// Base sets the base used when handling an int or uint of any size. By
+// default, a base of 10 is used unless the value is prefixed Go-style with
+// "0x", "0o", or "0b". Underscores are permitted between digits. Valid bases
+// are 2 through 36; any other value restores the default behavior. When a base
+// greater than 10 is set, a value must begin a word (e.g. "ff" in "/reg/ff",
+// but not in "/regff") so that letters are not mistaken for digits.
+func Base(base int) Option {
+	return func(c *config) {
+		if base < 2 || base > 36 {
+			base = 0
+		}
+
+		c.base = base
+	}
+}
+
+// Saturate clamps an int, uint, or float32 that is out of range for its type
+// to the nearest bound of that type rather than returning [ErrOutOfRange].
+func Saturate() Option {
+	return func(c *config) {
+		c.saturate = true
+	}
+}
+
+// BoolWords adds to the words that are accepted when handling a bool. By
+// default, "true", "t", "1", "yes", "y", "on", and "enabled" are accepted as
+// true, and "false", "f", "0", "no", "n", "off", and "disabled" are accepted as
+// false. Words are matched case-insensitively.
+func BoolWords(trueWords, falseWords []string) Option {
+	return func(c *config) {
+		c.trueWords = trueWords
+		c.falseWords = falseWords
+	}
+}
+
+// Special layouts that are usable with [Layouts].
+const (
+	LayoutUnix      = "unix"      // seconds since the Unix epoch
+	LayoutUnixMilli = "unixmilli" // milliseconds since the Unix epoch
+)
+
+// Layouts sets the layouts that are tried, in order, when handling a
+// time.Time. The layouts are as described by [time.Parse], with the addition of
+// [LayoutUnix] and [LayoutUnixMilli]. By default, [time.RFC3339], a date-only
+// layout ("2006-01-02"), and [LayoutUnix] are tried. Available as the repeatable
+// struct tag option "layout={layout}".
+func Layouts(layouts ...string) Option {
+	return func(c *config) {
+		c.layouts = layouts
+	}
+}
+
+// Location sets the location used when handling a time.Time. Layouts that do
+// not hold zone information are interpreted in the location, and Unix times
+// are converted to it. By default, UTC is used. Available as the struct tag
+// option "loc={name}" (as expected by [time.LoadLocation]).
+func Location(loc *time.Location) Option {
+	return func(c *config) {
+		if loc == nil {
+			loc = time.UTC
+		}
+
+		c.loc = loc
+	}
+}
+
+// ExtendedDurations extends the syntax accepted when handling a time.Duration.
+// Along with the syntax accepted by [time.ParseDuration], the units "d" (24
+// hours) and "w" (7 days) are accepted (e.g. "7d", "1w2d12h"), as are ISO 8601
+// durations (e.g. "P1DT2H", "PT1.5S"). Units of months and years are
+// ambiguous, so they result in [ErrDurationAmbiguous]. A duration that does not
+// fit within a time.Duration results in [ErrOutOfRange].
+func ExtendedDurations() Option {
+	return func(c *config) {
+		c.extDur = true
+	}
+}
+
+// Strict requires that a segment holds only the value when handling an int,
+// uint, float, complex, or [Decimal] of any size, as well as the math/big
+// types (e.g. "42", but not "id42"). By default, the first valid value within
+// the segment is used.
+func Strict() Option {
+	return func(c *config) {
+		c.strict = true
+	}
+}
+
+// MaxScale sets the maximum number of digits allowed after the decimal point
+// when handling a [Decimal]. Trailing zeros beyond the maximum are dropped,
+// and any other digits beyond it result in [ErrOutOfRange]. The default is 18.
+func MaxScale(scale int) Option {
+	return func(c *config) {
+		c.maxScale = scale
+	}
+}
+
+// FoldCase makes the matching of values case-insensitive when handling an
+// [Enum]. Available as the struct tag option "fold".
+func FoldCase() Option {
+	return func(c *config) {
+		c.fold = true
+	}
+}
+
+// Units sets the units that must follow the number when handling an int or uint
+// of any size. Each unit is mapped to the value it multiplies the number by
+// (e.g. "k": 1000), and the number may hold a fraction (e.g. "1.5k"). The
+// segment must hold only the number and a unit, and units are matched exactly.
+// Register an empty unit to also accept a number without a unit. A result that
+// is out of range for the destination results in [ErrOutOfRange] (see
+// [Saturate]). See [ByteSize] for sizes in SI and IEC units.
+func Units(units map[string]uint64) Option {
+	return func(c *config) {
+		c.units = units
+	}
+}
+
+// Encoding sets the encoding of the segment when handling a []byte. By
+// default, [EncodingRaw] is used. A segment that is not valid for the encoding
+// results in [ErrBytesInvalid]. Available as the struct tag option
+// "enc={name}", where the name is one of raw, hex, base64, rawbase64,
+// base64url, or rawbase64url.
+func Encoding(enc ByteEncoding) Option {
+	return func(c *config) {
+		c.enc = enc
+	}
+}
+
+// ByteLen sets the required length of a []byte once decoded (e.g. 32 for a
+// SHA-256 digest). A []byte of any other length results in [ErrBytesInvalid].
+// Available as the struct tag option "len={n}".
+func ByteLen(n int) Option {
+	return func(c *config) {
+		c.byteLen = n
+	}
+}
+
+// MaxJSONSize sets the maximum size, in bytes, of the decoded JSON when
+// handling a [JSONValue]. JSON that exceeds the maximum results in
+// [ErrOutOfRange]. The default is 4096.
+func MaxJSONSize(n int) Option {
+	return func(c *config) {
+		c.maxJSON = n
+	}
+}
+
+// TrimPrefix requires that a segment begins with the literal prefix, which is
+// removed before the segment is handled (e.g. with the prefix "@", "@alice" is
+// handled as "alice"). A segment without the prefix results in
+// [ErrDataUnparsable]. This is also useful when the prefix could otherwise be
+// mistaken for part of a value (e.g. with the prefix "ticket-",
+// "ticket-1234" is handled as 1234 rather than -1234). [SegmentScan] patterns
+// express the same with literal text (e.g. "ticket-{id:uint}").
+func TrimPrefix(prefix string) Option {
+	return func(c *config) {
+		c.prefix = prefix
+	}
+}
+
+// TrimSuffix requires that a segment ends with the literal suffix, which is
+// removed before the segment is handled (e.g. with the suffix ".git",
+// "parth.git" is handled as "parth"). A segment without the suffix results in
+// [ErrDataUnparsable].
+func TrimSuffix(suffix string) Option {
+	return func(c *config) {
+		c.suffix = suffix
+	}
+}
+
+// LenientSigns relaxes the rules for signs when locating an int or float of
+// any size within a segment. By default, a sign ("-" or "+") is only part of a
+// number when it directly precedes a digit and is not itself preceded by a
+// letter, digit, or underscore (e.g. "a-5" holds 5, while "x=-5" and "(+5)"
+// hold -5 and +5). With LenientSigns, any "-" that directly precedes a digit is
+// a sign (e.g. "a-5" holds -5), and "+" is not a sign for an int.
+func LenientSigns() Option {
+	return func(c *config) {
+		c.lenientSigns = true
+	}
+}
+
+// NormalizeDigits converts the decimal digits of any script (e.g. the
+// full-width "４２" or the Arabic-Indic "٤٢") to ASCII digits before a number is
+// handled. By default, only ASCII digits are part of a number.
+func NormalizeDigits() Option {
+	return func(c *config) {
+		c.normDigits = true
+	}
+}
+
+// KeyFold makes the matching of a "key" segment case-insensitive, using
+// Unicode case folding (e.g. "Users" and "USERS" match "users").
+func KeyFold() Option {
+	return func(c *config) {
+		c.keyFold = strings.EqualFold
+	}
+}
+
+// KeyFoldASCII makes the matching of a "key" segment case-insensitive for
+// ASCII letters only. Other characters must match exactly.
+func KeyFoldASCII() Option {
+	return func(c *config) {
+		c.keyFold = asciiEqualFold
+	}
+}
+
+// KeyAliases sets additional keys that are accepted in place of the "key"
+// (e.g. the legacy "user" for "users"). The first segment that matches the key
+// or any of the aliases is used.
+func KeyAliases(keys ...string) Option {
+	return func(c *config) {
+		c.keyAliases = keys
+	}
+}
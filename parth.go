@@ -3,22 +3,42 @@
 // parameters.
 //
 // Valid values are:
-//   - builtin: *string, *bool, *int, *int64, *int32, *int16, *int8, *uint,
-//     *uint64, *uint32, *uint16, *uint8, *float64, *float32
-//   - stdlib: [*time.Duration], [encoding.TextUnmarshaler], [flag.Value]
+//   - builtin: *string, *[]byte, *bool, *int, *int64, *int32, *int16, *int8,
+//     *uint, *uint64, *uint32, *uint16, *uint8, *float64, *float32,
+//     *complex128, *complex64
+//   - stdlib: [*time.Duration], [*time.Time], [*netip.Addr],
+//     [*netip.AddrPort], [*netip.Prefix], [*big.Int], [*big.Float],
+//     [*big.Rat], [*sql.NullBool], [*sql.NullByte], [*sql.NullFloat64],
+//     [*sql.NullInt16], [*sql.NullInt32], [*sql.NullInt64],
+//     [*sql.NullString], [*sql.NullTime], [encoding.TextUnmarshaler],
+//     [flag.Value], [sql.Scanner]
+//   - parth: [*ByteSize], [*Decimal], [*Enum], [*UUID], [*ULID], [*KSUID],
+//     [*Base62], [*Version], [*Tile], [*LatLng], [*JSONValue]
 //
-// When handling any size of int, uint, or float, the first valid value within
-// the specified segment will be used. Three important terms used in this
-// package are "segment", "sequent", and "span". A segment is any single path
-// section. A sequent is a segment that follows a "key" path section. Segments
-// are able to be unmarshaled into variables. A span is any multiple path
-// sections, and is handled as a string.
+// When handling any size of int, uint, float, or complex, the first valid value
+// within the specified segment will be used. Integers may be prefixed Go-style
+// (e.g. "0x1F", "0o17", "0b101"), and may contain underscores between digits.
+// A sign ("-" or "+") is only part of a value when it is not preceded by a
+// letter, digit, or underscore (e.g. "ticket-12" holds 12, and "x=-12" holds
+// -12); see [LenientSigns]. Letters and digits of any script are taken into
+// account, but only ASCII digits are part of a value (see [NormalizeDigits]).
+// A segment that is not valid UTF-8 results in [ErrUTF8Invalid].
+//
+// The [database/sql] nullable types are handled as their underlying type. If
+// the segment to be unmarshaled into any [sql.Scanner] does not exist, it is
+// scanned as NULL (i.e. Valid is false) and no error is returned. A
+// sql.Null[T] is handled as its T when T is a valid value. Other scanners are
+// provided the segment as a string.
+//
+// Three important terms used in this package are "segment", "sequent", and
+// "span". A segment is any single path section. A sequent is a segment that
+// follows a "key" path section. Segments are able to be unmarshaled into
+// variables. A span is any multiple path sections, and is handled as a string.
 package parth
 
 import (
-	"encoding"
 	"errors"
-	"flag"
+	"net/netip"
 	"time"
 )
 
@@ -32,109 +52,130 @@ var (
 	ErrKeySegNotFound   = errors.New("segment not found by key")
 
 	ErrDataUnparsable = errors.New("data cannot be parsed")
+	ErrOutOfRange     = errors.New("data out of range")
+
+	ErrDurationAmbiguous = errors.New("duration unit is ambiguous (months, years)")
+
+	ErrPatternInvalid  = errors.New("pattern is invalid")
+	ErrPatternMismatch = errors.New("segment does not match pattern")
+	ErrTagInvalid      = errors.New("struct tag is invalid")
+
+	ErrBytesInvalid = errors.New("bytes have invalid encoding or length")
+	ErrUTF8Invalid  = errors.New("data is not valid UTF-8")
 )
 
 // Segment locates the path segment indicated by index i. If the index is
-// negative, the negative count begins with the last segment.
-func Segment(v any, path string, i int) error {
-	var err error
-
+// negative, the negative count begins with the last segment (a trailing slash
+// is ignored).
+func Segment(v any, path string, i int, opts ...Option) error {
 	switch v := v.(type) {
-	case *bool:
-		*v, err = segmentToBool(path, i)
-
-	case *float32:
-		var f float64
-		f, err = segmentToFloatN(path, i, 32)
-		*v = float32(f)
-
-	case *float64:
-		*v, err = segmentToFloatN(path, i, 64)
-
-	case *int:
-		var n int64
-		n, err = segmentToIntN(path, i, 0)
-		*v = int(n)
-
-	case *int16:
-		var n int64
-		n, err = segmentToIntN(path, i, 16)
-		*v = int16(n)
-
-	case *int32:
-		var n int64
-		n, err = segmentToIntN(path, i, 32)
-		*v = int32(n)
-
-	case *int64:
-		*v, err = segmentToIntN(path, i, 64)
-
-	case *int8:
-		var n int64
-		n, err = segmentToIntN(path, i, 8)
-		*v = int8(n)
-
-	case *string:
-		*v, err = segmentToString(path, i)
-
-	case *uint:
-		var n uint64
-		n, err = segmentToUintN(path, i, 0)
-		*v = uint(n)
-
-	case *uint16:
-		var n uint64
-		n, err = segmentToUintN(path, i, 16)
-		*v = uint16(n)
-
-	case *uint32:
-		var n uint64
-		n, err = segmentToUintN(path, i, 32)
-		*v = uint32(n)
-
-	case *uint64:
-		*v, err = segmentToUintN(path, i, 64)
-
-	case *uint8:
-		var n uint64
-		n, err = segmentToUintN(path, i, 8)
-		*v = uint8(n)
-
-	case *time.Duration:
-		var s string
-		s, err = segmentToString(path, i)
-		if err == nil {
-			d, err := time.ParseDuration(s)
-			if err == nil {
-				*v = d
-			}
-		}
-
-	case encoding.TextUnmarshaler:
-		var s string
-		s, err = segmentToString(path, i)
-		if err == nil {
-			err = v.UnmarshalText([]byte(s))
-		}
-
-	case flag.Value:
-		var s string
-		s, err = segmentToString(path, i)
-		if err == nil {
-			err = v.Set(s)
-		}
-
-	default:
-		err = ErrUnknownType
-	}
-
-	return err
+	case *netip.Prefix:
+		var err error
+		*v, err = segmentToPrefix(path, i)
+		return err
+
+	case *Tile:
+		var err error
+		*v, err = segmentToTile(path, i)
+		return err
+	}
+
+	s, err := segmentToString(path, i)
+	if err != nil {
+		return missingSegment(v, err)
+	}
+
+	c := makeConfig(opts)
+
+	if s, err = trimAffixes(s, c); err != nil {
+		return err
+	}
+
+	return unmarshalSegment(v, s, c)
+}
+
+// SegmentExt is similar to [Segment], except that any extension is split from
+// the located segment before it is unmarshaled into v, and the extension is
+// returned without its leading dot (e.g. the segment "42.json" unmarshals 42,
+// and returns "json"). An extension follows the last dot of the segment, must
+// begin with a letter, and may hold only letters and digits. If there is no
+// extension, the entire segment is unmarshaled and an empty string is
+// returned. v may be nil if only the extension is needed.
+func SegmentExt(v any, path string, i int, opts ...Option) (string, error) {
+	s, err := segmentToString(path, i)
+	if err != nil {
+		return "", err
+	}
+
+	s, ext := splitExt(s)
+	if v == nil {
+		return ext, nil
+	}
+
+	c := makeConfig(opts)
+
+	if s, err = trimAffixes(s, c); err != nil {
+		return ext, err
+	}
+
+	return ext, unmarshalSegment(v, s, c)
+}
+
+// SegmentNumber is similar to [Segment], except that it uses the number
+// indicated by index n from within the located segment. For example, the
+// numbers within "v2.14.3" are 2, 14, and 3 (as ints). If index n is negative,
+// the negative count begins with the last number. Floats are located when v
+// is a float or complex of any size, [*Decimal], [*big.Float], or [*big.Rat],
+// otherwise ints are located.
+func SegmentNumber(v any, path string, i, n int, opts ...Option) error {
+	s, err := segmentToString(path, i)
+	if err != nil {
+		return err
+	}
+
+	c := makeConfig(opts)
+
+	if s, err = trimAffixes(s, c); err != nil {
+		return err
+	}
+
+	if s, err = numericString(s, c); err != nil {
+		return err
+	}
+
+	s, ok := nthNumberFromString(s, n, nextNumberFuncFor(v, c.base, c.lenientSigns))
+	if !ok {
+		return ErrDataUnparsable
+	}
+
+	return unmarshalSegment(v, s, c)
+}
+
+// SegmentNumbers locates the path segment indicated by index i, and returns
+// all of the ints found within it.
+func SegmentNumbers(path string, i int, opts ...Option) ([]string, error) {
+	s, err := segmentToString(path, i)
+	if err != nil {
+		return nil, err
+	}
+
+	c := makeConfig(opts)
+
+	if s, err = trimAffixes(s, c); err != nil {
+		return nil, err
+	}
+
+	if s, err = numericString(s, c); err != nil {
+		return nil, err
+	}
+
+	return numbersFromString(s, nextNumberFuncFor(nil, c.base, c.lenientSigns)), nil
 }
 
 // Sequent is similar to [Segment], except that it locates the segment that is
 // subsequent to the "key" segment.
-func Sequent(v any, path, key string) error {
-	return SubSeg(v, path, key, 0)
+func Sequent(v any, path, key string, opts ...Option) error {
+	return SubSeg(v, path, key, 0, opts...)
 }
 
 // Span returns the path segments between indexes i and j, including the segment
@@ -176,102 +217,90 @@ func Span(path string, i, j int) (string, error) {
 	return path[f:l], nil
 }
 
+// SpanPrefix locates the two path segments that begin with the segment
+// indicated by index i, and handles them as an IP address and prefix length
+// (e.g. the path "/subnets/10.0.0.0/8" holds the prefix "10.0.0.0/8" at index
+// 1). If the index is negative, the negative count begins with the last
+// segment. A single segment holding an escaped slash (e.g. "10.0.0.0%2F8") is
+// also accepted. [Segment] and [SubSeg] behave in the same way when v is a
+// *netip.Prefix.
+func SpanPrefix(path string, i int) (netip.Prefix, error) {
+	return segmentToPrefix(path, i)
+}
+
+// SpanTile locates the three path segments that begin with the segment
+// indicated by index i, and handles them as the zoom, x, and y of a map tile
+// (e.g. the path "/tiles/12/1205/1539.png" holds the tile 12/1205/1539 at index
+// 1). If the index is negative, the negative count begins with the last
+// segment. The last segment may hold a suffix that follows the y value (e.g.
+// ".png", or "@2x.png"). If x or y is not less than 2^zoom, [ErrOutOfRange] is
+// returned. [Segment] and [SubSeg] behave in the same way when v is a *Tile.
+func SpanTile(path string, i int) (Tile, error) {
+	return segmentToTile(path, i)
+}
+
+// SpanTime is similar to [Span], but the located segments are handled as the
+// year, month, day, and hour of a time.Time (in that order). Partial precision
+// is allowed (e.g. only the year, or the year and month), so the span must
+// hold between one and four segments, each of which must consist of only
+// digits. The location can be set using the [Location] option.
+func SpanTime(path string, i, j int, opts ...Option) (time.Time, error) {
+	s, err := Span(path, i, j)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return spanToTime(s, makeConfig(opts))
+}
+
 // SubSeg is similar to both [Sequent] and [Segment]. It first locates the
 // "key", then uses index i to locate a segment. For example, to access the
 // segment immediately after the "key", an index of 0 should be provided (which
 // is how [Sequent] is implemented). Technically, a negative index is valid,
-// but it is nonsensical in this function.
-func SubSeg(v any, path, key string, i int) error {
-	var err error
+// but it is nonsensical in this function. The matching of the key can be
+// configured as with [SubSpan].
+func SubSeg(v any, path, key string, i int, opts ...Option) error {
+	c := makeConfig(opts)
 
 	switch v := v.(type) {
-	case *bool:
-		*v, err = subSegToBool(path, key, i)
-
-	case *float32:
-		var f float64
-		f, err = subSegToFloatN(path, key, i, 32)
-		*v = float32(f)
-
-	case *float64:
-		*v, err = subSegToFloatN(path, key, i, 64)
-
-	case *int:
-		var n int64
-		n, err = subSegToIntN(path, key, i, 0)
-		*v = int(n)
-
-	case *int16:
-		var n int64
-		n, err = subSegToIntN(path, key, i, 16)
-		*v = int16(n)
-
-	case *int32:
-		var n int64
-		n, err = subSegToIntN(path, key, i, 32)
-		*v = int32(n)
-
-	case *int64:
-		*v, err = subSegToIntN(path, key, i, 64)
-
-	case *int8:
-		var n int64
-		n, err = subSegToIntN(path, key, i, 8)
-		*v = int8(n)
-
-	case *string:
-		*v, err = subSegToString(path, key, i)
-
-	case *uint:
-		var n uint64
-		n, err = subSegToUintN(path, key, i, 0)
-		*v = uint(n)
-
-	case *uint16:
-		var n uint64
-		n, err = subSegToUintN(path, key, i, 16)
-		*v = uint16(n)
-
-	case *uint32:
-		var n uint64
-		n, err = subSegToUintN(path, key, i, 32)
-		*v = uint32(n)
-
-	case *uint64:
-		*v, err = subSegToUintN(path, key, i, 64)
-
-	case *uint8:
-		var n uint64
-		n, err = subSegToUintN(path, key, i, 8)
-		*v = uint8(n)
-
-	case encoding.TextUnmarshaler:
-		var s string
-		s, err = subSegToString(path, key, i)
-		if err == nil {
-			err = v.UnmarshalText([]byte(s))
-		}
-
-	default:
-		err = ErrUnknownType
-	}
-
-	return err
+	case *netip.Prefix:
+		var err error
+		*v, err = subSegToPrefix(path, key, i, c)
+		return err
+
+	case *Tile:
+		var err error
+		*v, err = subSegToTile(path, key, i, c)
+		return err
+	}
+
+	s, err := subSegToString(path, key, i, c)
+	if err != nil {
+		return missingSegment(v, err)
+	}
+
+	if s, err = trimAffixes(s, c); err != nil {
+		return err
+	}
+
+	return unmarshalSegment(v, s, c)
 }
 
 // SubSpan is similar to [Span], but only handles the portion of the path
-// subsequent to the "key".
-func SubSpan(path, key string, i, j int) (string, error) {
-	si, ok := segIndexByKey(path, key)
+// subsequent to the "key". A key that holds slashes is matched against an
+// equal number of segments (e.g. "10.0.0.0/8"). The matching of the key can
+// be configured using the [KeyFold], [KeyFoldASCII], and [KeyAliases] options.
+func SubSpan(path, key string, i, j int, opts ...Option) (string, error) {
+	si, ks, ok := segIndexByKey(path, key, makeConfig(opts))
 	if !ok {
 		return "", ErrKeySegNotFound
 	}
 
 	if i >= 0 {
-		i++
+		i += ks
 	}
 	if j > 0 {
-		j++
+		j += ks
 	}
 
 	s, err := Span(path[si:], i, j)
@@ -282,6 +311,17 @@ func SubSpan(path, key string, i, j int) (string, error) {
 	return s, nil
 }
 
+// SubSpanTime is similar to [SpanTime], but handles the segments subsequent to
+// the "key". Up to four segments consisting of only digits are used.
+func SubSpanTime(path, key string, opts ...Option) (time.Time, error) {
+	s, err := SubSpan(path, key, 0, 0, opts...)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return spanToTime(leadingDigitSegs(s, 4), makeConfig(opts))
+}
+
 // Parth manages path and error data for processing a single path multiple
 // times while handling errors only once. Only the first encountered error is
 // stored since all subsequent calls to Parth methods will have no effect.
@@ -304,8 +344,8 @@ func NewBySpan(path string, i, j int) *Parth {
 
 // NewBySubSpan constructs a pointer to an instance of [Parth] after
 // preprocessing the provided path with [SubSpan].
-func NewBySubSpan(path, key string, i, j int) *Parth {
-	s, err := SubSpan(path, key, i, j)
+func NewBySubSpan(path, key string, i, j int, opts ...Option) *Parth {
+	s, err := SubSpan(path, key, i, j, opts...)
 	return &Parth{s, err}
 }
 
@@ -314,18 +354,69 @@ func (p *Parth) Err() error {
 	return p.err
 }
 
+// Ext returns the extension of the last path segment, as described by
+// [SegmentExt], or an empty string if there is none.
+func (p *Parth) Ext() string {
+	ext, _ := SegmentExt(nil, p.path, -1)
+	return ext
+}
+
 // Segment operates the same as the package-level function [Segment].
-func (p *Parth) Segment(v any, i int) {
+func (p *Parth) Segment(v any, i int, opts ...Option) {
+	if p.err != nil {
+		return
+	}
+
+	p.err = Segment(v, p.path, i, opts...)
+}
+
+// SegmentExt operates the same as the package-level function [SegmentExt].
+func (p *Parth) SegmentExt(v any, i int, opts ...Option) string {
+	if p.err != nil {
+		return ""
+	}
+
+	ext, err := SegmentExt(v, p.path, i, opts...)
+	p.err = err
+
+	return ext
+}
+
+// SegmentNumber operates the same as the package-level function
+// [SegmentNumber].
+func (p *Parth) SegmentNumber(v any, i, n int, opts ...Option) {
 	if p.err != nil {
 		return
 	}
 
-	p.err = Segment(v, p.path, i)
+	p.err = SegmentNumber(v, p.path, i, n, opts...)
+}
+
+// SegmentNumbers operates the same as the package-level function
+// [SegmentNumbers].
+func (p *Parth) SegmentNumbers(i int, opts ...Option) []string {
+	if p.err != nil {
+		return nil
+	}
+
+	vs, err := SegmentNumbers(p.path, i, opts...)
+	p.err = err
+
+	return vs
+}
+
+// SegmentScan operates the same as the package-level function [SegmentScan].
+func (p *Parth) SegmentScan(i int, pattern string, dst any, opts ...Option) {
+	if p.err != nil {
+		return
+	}
+
+	p.err = SegmentScan(p.path, i, pattern, dst, opts...)
 }
 
 // Sequent operates the same as the package-level function [Sequent].
-func (p *Parth) Sequent(v any, key string) {
-	p.SubSeg(v, key, 0)
+func (p *Parth) Sequent(v any, key string, opts ...Option) {
+	p.SubSeg(v, key, 0, opts...)
 }
 
 // Span operates the same as the package-level function [Span].
@@ -340,23 +431,71 @@ func (p *Parth) Span(i, j int) string {
 	return s
 }
 
+// SpanPrefix operates the same as the package-level function [SpanPrefix].
+func (p *Parth) SpanPrefix(i int) netip.Prefix {
+	if p.err != nil {
+		return netip.Prefix{}
+	}
+
+	pfx, err := SpanPrefix(p.path, i)
+	p.err = err
+
+	return pfx
+}
+
+// SpanTile operates the same as the package-level function [SpanTile].
+func (p *Parth) SpanTile(i int) Tile {
+	if p.err != nil {
+		return Tile{}
+	}
+
+	t, err := SpanTile(p.path, i)
+	p.err = err
+
+	return t
+}
+
+// SpanTime operates the same as the package-level function [SpanTime].
+func (p *Parth) SpanTime(i, j int, opts ...Option) time.Time {
+	if p.err != nil {
+		return time.Time{}
+	}
+
+	t, err := SpanTime(p.path, i, j, opts...)
+	p.err = err
+
+	return t
+}
+
 // SubSeg operates the same as the package-level function [SubSeg].
-func (p *Parth) SubSeg(v any, key string, i int) {
+func (p *Parth) SubSeg(v any, key string, i int, opts ...Option) {
 	if p.err != nil {
 		return
 	}
 
-	p.err = SubSeg(v, p.path, key, i)
+	p.err = SubSeg(v, p.path, key, i, opts...)
 }
 
 // SubSpan operates the same as the package-level function [SubSpan].
-func (p *Parth) SubSpan(key string, i, j int) string {
+func (p *Parth) SubSpan(key string, i, j int, opts ...Option) string {
 	if p.err != nil {
 		return ""
 	}
 
-	s, err := SubSpan(p.path, key, i, j)
+	s, err := SubSpan(p.path, key, i, j, opts...)
 	p.err = err
 
 	return s
 }
+
+// SubSpanTime operates the same as the package-level function [SubSpanTime].
+func (p *Parth) SubSpanTime(key string, opts ...Option) time.Time {
+	if p.err != nil {
+		return time.Time{}
+	}
+
+	t, err := SubSpanTime(p.path, key, opts...)
+	p.err = err
+
+	return t
+}
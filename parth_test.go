@@ -1,9 +1,16 @@
 package parth
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"net/netip"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 )
 
 func TestBhvrSegment(t *testing.T) {
@@ -11,6 +18,8 @@ func TestBhvrSegment(t *testing.T) {
 	key := ""
 
 	t.Run("bool", applyToBoolTFunc(path, key, pti(3), true))
+	t.Run("complex64", applyToComplex64TFunc(path, key, pti(5), 3.3))
+	t.Run("complex128", applyToComplex128TFunc(path, key, pti(5), 3.3))
 	t.Run("float32", applyToFloat32TFunc(path, key, pti(5), 3.3))
 	t.Run("float64", applyToFloat64TFunc(path, key, pti(5), 3.3))
 	t.Run("int", applyToIntTFunc(path, key, pti(1), 4))
@@ -19,6 +28,7 @@ func TestBhvrSegment(t *testing.T) {
 	t.Run("int64", applyToInt64TFunc(path, key, pti(1), 4))
 	t.Run("int8", applyToInt8TFunc(path, key, pti(1), 4))
 	t.Run("string", applyToStringTFunc(path, key, pti(0), "junk"))
+	t.Run("stringNeg", applyToStringTFunc("/zero/one/two", key, pti(-1), "two"))
 	t.Run("uint", applyToUintTFunc(path, key, pti(-1), 3))
 	t.Run("uint16", applyToUint16TFunc(path, key, pti(-1), 3))
 	t.Run("uint32", applyToUint32TFunc(path, key, pti(-1), 3))
@@ -33,11 +43,39 @@ func TestBhvrSegment(t *testing.T) {
 	})
 }
 
+func TestBhvrSegmentNegIndex(t *testing.T) {
+	tests := []struct {
+		path string
+		i    int
+		want string
+	}{
+		{"/zero/one/two", -1, "two"},
+		{"/zero/one/two", -3, "zero"},
+		{"/zero/one/two/", -1, "two"},
+		{"/zero/one/two/", -2, "one"},
+		{"zero/one", -2, "zero"},
+	}
+
+	for _, tt := range tests {
+		var got string
+		err := Segment(&got, tt.path, tt.i)
+		if unx(t, subject(tt.path, "", tt.i), err) {
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, subject(tt.path, "", tt.i), got, tt.want)
+		}
+	}
+}
+
 func TestBhvrSequent(t *testing.T) {
 	path := "/junk/4/key/true/other/3.3/"
 	var i *int
 
 	t.Run("bool", applyToBoolTFunc(path, "key", i, true))
+	t.Run("complex64", applyToComplex64TFunc(path, "other", i, 3.3))
+	t.Run("complex128", applyToComplex128TFunc(path, "other", i, 3.3))
 	t.Run("float32", applyToFloat32TFunc(path, "other", i, 3.3))
 	t.Run("float64", applyToFloat64TFunc(path, "other", i, 3.3))
 	t.Run("int", applyToIntTFunc(path, "junk", i, 4))
@@ -60,6 +98,95 @@ func TestBhvrSequent(t *testing.T) {
 	})
 }
 
+func TestBhvrSegmentNumber(t *testing.T) {
+	path := "/img/800x600/v2.14.3/1.5x2.5"
+
+	t.Run("int", func(t *testing.T) {
+		var got int
+		err := SegmentNumber(&got, path, 1, 1)
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		if want := 600; got != want {
+			t.Errorf(gwFmt, got, want)
+		}
+	})
+
+	t.Run("float", func(t *testing.T) {
+		var got float32
+		err := SegmentNumber(&got, path, -1, -1)
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		if want := float32(2.5); got != want {
+			t.Errorf(gwFmt, got, want)
+		}
+	})
+
+	t.Run("complex", func(t *testing.T) {
+		var got complex128
+		err := SegmentNumber(&got, path, -1, 1)
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		if want := complex(2.5, 0); got != want {
+			t.Errorf(gwFmt, got, want)
+		}
+	})
+
+	t.Run("decimal", func(t *testing.T) {
+		var got Decimal
+		err := SegmentNumber(&got, path, -1, 1)
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		if want := "2.5"; got.String() != want {
+			t.Errorf(gwFmt, got, want)
+		}
+	})
+
+	t.Run("big", func(t *testing.T) {
+		var f big.Float
+		var r big.Rat
+		p := New(path)
+		p.SegmentNumber(&f, -1, 0)
+		p.SegmentNumber(&r, -1, 1)
+		if unx(t, t.Name(), p.Err()) {
+			return
+		}
+
+		if want := big.NewFloat(1.5); f.Cmp(want) != 0 {
+			t.Errorf(gwFmt, &f, want)
+		}
+
+		if want := big.NewRat(5, 2); r.Cmp(want) != 0 {
+			t.Errorf(gwFmt, &r, want)
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		var got uint
+		err := SegmentNumber(&got, path, 2, 3)
+		exp(t, t.Name(), err)
+	})
+
+	t.Run("all", func(t *testing.T) {
+		got, err := SegmentNumbers(path, 2)
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		want := []string{"2", "14", "3"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf(gwFmt, got, want)
+		}
+	})
+}
+
 func TestBhvrSpan(t *testing.T) {
 	path := "/zero/one/two/three/four"
 
@@ -96,6 +223,8 @@ func TestBhvrSubSeg(t *testing.T) {
 	path := "/junk/4/key/true/other/3.3/"
 
 	t.Run("bool", applyToBoolTFunc(path, "junk", pti(2), true))
+	t.Run("complex64", applyToComplex64TFunc(path, "true", pti(1), 3.3))
+	t.Run("complex128", applyToComplex128TFunc(path, "true", pti(1), 3.3))
 	t.Run("float32", applyToFloat32TFunc(path, "true", pti(1), 3.3))
 	t.Run("float64", applyToFloat64TFunc(path, "true", pti(1), 3.3))
 	t.Run("int", applyToIntTFunc(path, "junk", pti(0), 4))
@@ -150,6 +279,798 @@ func TestBhvrSubSpan(t *testing.T) {
 	}
 }
 
+func TestBhvrBase(t *testing.T) {
+	path := "/registers/0x1F/ff/1_000/-0b11"
+
+	tests := []struct {
+		name string
+		key  string
+		i    int
+		opts []Option
+		want int64
+		ck   checkFunc
+	}{
+		{"prefixed hex", "", 1, nil, 31, unx},
+		{"hint hex", "", 2, []Option{Base(16)}, 255, unx},
+		{"no hint hex", "", 2, nil, 0, exp},
+		{"underscores", "", 3, nil, 1000, unx},
+		{"prefixed bin", "", 4, nil, -3, unx},
+		{"key hint hex", "0x1F", 0, []Option{Base(16)}, 255, unx},
+		{"key prefixed hint", "registers", 0, []Option{Base(16)}, 31, unx},
+	}
+
+	for _, tt := range tests {
+		var got int64
+		var err error
+		if tt.key == "" {
+			err = Segment(&got, path, tt.i, tt.opts...)
+		} else {
+			err = SubSeg(&got, path, tt.key, tt.i, tt.opts...)
+		}
+		if tt.ck(t, tt.name, err) {
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBhvrOutOfRange(t *testing.T) {
+	path := "/int/300/neg/-300/float/1e39/hex/1_0000/big/-99999999999999999999"
+
+	t.Run("error", func(t *testing.T) {
+		var got int8
+		err := Segment(&got, path, 1)
+		if !errors.Is(err, ErrOutOfRange) {
+			t.Fatalf(gwFmt, err, ErrOutOfRange)
+		}
+
+		var numErr *strconv.NumError
+		if !errors.As(err, &numErr) {
+			t.Errorf(gwFmt, err, "{*strconv.NumError}")
+		}
+
+		if errors.Is(err, ErrDataUnparsable) {
+			t.Errorf(gwFmt, err, ErrOutOfRange)
+		}
+	})
+
+	t.Run("error/subSeg", func(t *testing.T) {
+		var got float32
+		err := Sequent(&got, path, "float")
+		if !errors.Is(err, ErrOutOfRange) {
+			t.Errorf(gwFmt, err, ErrOutOfRange)
+		}
+	})
+
+	t.Run("saturate", func(t *testing.T) {
+		var i8 int8
+		var u8 uint8
+		var u16 uint16
+		var i64 int64
+		var f32 float32
+
+		p := New(path)
+		p.Segment(&i8, 1, Saturate())
+		p.Sequent(&u8, "int", Saturate())
+		p.Sequent(&u16, "hex", Saturate(), Base(16))
+		p.Sequent(&i64, "big", Saturate())
+		p.Sequent(&f32, "float", Saturate())
+		if unx(t, t.Name(), p.Err()) {
+			return
+		}
+
+		if i8 != math.MaxInt8 {
+			t.Errorf(gwFmt, i8, math.MaxInt8)
+		}
+
+		if u8 != math.MaxUint8 {
+			t.Errorf(gwFmt, u8, math.MaxUint8)
+		}
+
+		if u16 != math.MaxUint16 {
+			t.Errorf(gwFmt, u16, math.MaxUint16)
+		}
+
+		if i64 != math.MinInt64 {
+			t.Errorf(gwFmt, i64, math.MinInt64)
+		}
+
+		if f32 != math.MaxFloat32 {
+			t.Errorf(gwFmt, f32, math.MaxFloat32)
+		}
+
+		p.SubSeg(&i8, "int", 2, Saturate())
+		if unx(t, t.Name(), p.Err()) {
+			return
+		}
+
+		if i8 != math.MinInt8 {
+			t.Errorf(gwFmt, i8, math.MinInt8)
+		}
+	})
+}
+
+func TestBhvrTime(t *testing.T) {
+	path := "/events/2024-10-17/since/1697500000/at/2024-10-17T08:30:00Z/ms/1697500000123"
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	tests := []struct {
+		name string
+		key  string
+		i    int
+		opts []Option
+		want time.Time
+		ck   checkFunc
+	}{
+		{"date", "", 1, nil, time.Date(2024, 10, 17, 0, 0, 0, 0, time.UTC), unx},
+		{"unix", "since", 0, nil, time.Unix(1697500000, 0), unx},
+		{"rfc3339", "at", 0, nil, time.Date(2024, 10, 17, 8, 30, 0, 0, time.UTC), unx},
+		{"unixMilli", "ms", 0, []Option{Layouts(LayoutUnixMilli)}, time.UnixMilli(1697500000123), unx},
+		{"location", "events", 0, []Option{Location(ny)}, time.Date(2024, 10, 17, 0, 0, 0, 0, ny), unx},
+		{"custom", "", 1, []Option{Layouts("2006-01")}, time.Time{}, exp},
+		{"bad", "", 0, nil, time.Time{}, exp},
+	}
+
+	for _, tt := range tests {
+		var got time.Time
+		var err error
+		if tt.key == "" {
+			err = Segment(&got, path, tt.i, tt.opts...)
+		} else {
+			err = SubSeg(&got, path, tt.key, tt.i, tt.opts...)
+		}
+		if tt.ck(t, tt.name, err) {
+			continue
+		}
+
+		if !got.Equal(tt.want) {
+			t.Errorf(gwxFmt, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBhvrSpanTime(t *testing.T) {
+	path := "/blog/2024/10/17/08/post/2023/02/29"
+
+	tests := []struct {
+		name string
+		i, j int
+		want time.Time
+		ck   checkFunc
+	}{
+		{"hour", 1, 5, time.Date(2024, 10, 17, 8, 0, 0, 0, time.UTC), unx},
+		{"day", 1, 4, time.Date(2024, 10, 17, 0, 0, 0, 0, time.UTC), unx},
+		{"month", 1, 3, time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), unx},
+		{"year", 1, 2, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), unx},
+		{"negative", -3, -1, time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), unx},
+		{"invalid day", -3, 0, time.Time{}, exp},
+		{"too many", 1, 6, time.Time{}, exp},
+		{"not digits", 0, 2, time.Time{}, exp},
+	}
+
+	for _, tt := range tests {
+		got, err := SpanTime(path, tt.i, tt.j)
+		if tt.ck(t, tt.name, err) {
+			continue
+		}
+
+		if !got.Equal(tt.want) {
+			t.Errorf(gwxFmt, tt.name, got, tt.want)
+		}
+	}
+
+	t.Run("subSpan", func(t *testing.T) {
+		got, err := SubSpanTime(path, "blog")
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		want := time.Date(2024, 10, 17, 8, 0, 0, 0, time.UTC)
+		if !got.Equal(want) {
+			t.Errorf(gwFmt, got, want)
+		}
+
+		_, err = SubSpanTime(path, "post")
+		if !errors.Is(err, ErrOutOfRange) {
+			t.Errorf(gwFmt, err, ErrOutOfRange)
+		}
+	})
+}
+
+func TestBhvrDuration(t *testing.T) {
+	path := "/retention/7d/cache/1h30m"
+
+	tests := []struct {
+		name string
+		key  string
+		opts []Option
+		want time.Duration
+		ck   checkFunc
+	}{
+		{"std", "cache", nil, 90 * time.Minute, unx},
+		{"std ext", "retention", nil, 0, exp},
+		{"ext", "retention", []Option{ExtendedDurations()}, 7 * 24 * time.Hour, unx},
+		{"ext std", "cache", []Option{ExtendedDurations()}, 90 * time.Minute, unx},
+	}
+
+	for _, tt := range tests {
+		var got time.Duration
+		err := Sequent(&got, path, tt.key, tt.opts...)
+		if tt.ck(t, tt.name, err) {
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBhvrNetip(t *testing.T) {
+	path := "/subnets/10.0.0.0/8/hosts/10.1.2.3/svc/[::1]:8080/esc/fd00::%2F64"
+
+	t.Run("addr", func(t *testing.T) {
+		var got netip.Addr
+		err := Sequent(&got, path, "hosts")
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		if want := netip.MustParseAddr("10.1.2.3"); got != want {
+			t.Errorf(gwFmt, got, want)
+		}
+	})
+
+	t.Run("addrPort", func(t *testing.T) {
+		var got netip.AddrPort
+		err := Segment(&got, path, 6)
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		if want := netip.MustParseAddrPort("[::1]:8080"); got != want {
+			t.Errorf(gwFmt, got, want)
+		}
+	})
+
+	tests := []struct {
+		name string
+		get  func() (netip.Prefix, error)
+		want string
+		ck   checkFunc
+	}{
+		{"span", func() (netip.Prefix, error) { return SpanPrefix(path, 1) }, "10.0.0.0/8", unx},
+		{"span neg", func() (netip.Prefix, error) { return SpanPrefix(path, -8) }, "10.0.0.0/8", unx},
+		{"span last", func() (netip.Prefix, error) { return SpanPrefix(path, -1) }, "fd00::/64", unx},
+		{"span bad", func() (netip.Prefix, error) { return SpanPrefix(path, 0) }, "invalid Prefix", exp},
+		{"segment", func() (p netip.Prefix, err error) { err = Segment(&p, path, 1); return p, err }, "10.0.0.0/8", unx},
+		{"sequent", func() (p netip.Prefix, err error) { err = Sequent(&p, path, "subnets"); return p, err }, "10.0.0.0/8", unx},
+		{"sequent esc", func() (p netip.Prefix, err error) { err = Sequent(&p, path, "esc"); return p, err }, "fd00::/64", unx},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.get()
+		if tt.ck(t, tt.name, err) {
+			continue
+		}
+
+		if got.String() != tt.want {
+			t.Errorf(gwxFmt, tt.name, got, tt.want)
+		}
+	}
+
+	t.Run("prefixKey", func(t *testing.T) {
+		var got string
+		err := Sequent(&got, path, "10.0.0.0/8")
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		if want := "hosts"; got != want {
+			t.Errorf(gwFmt, got, want)
+		}
+	})
+}
+
+func TestBhvrBool(t *testing.T) {
+	path := "/flags/x/on/toggle/Enabled/beta/sure/legacy/nope"
+
+	tests := []struct {
+		name string
+		key  string
+		i    int
+		opts []Option
+		want bool
+		ck   checkFunc
+	}{
+		{"index on", "", 2, nil, true, unx},
+		{"key enabled", "toggle", 0, nil, true, unx},
+		{"word true", "beta", 0, []Option{BoolWords([]string{"sure"}, nil)}, true, unx},
+		{"word false", "legacy", 0, []Option{BoolWords(nil, []string{"NOPE"})}, false, unx},
+		{"unknown", "beta", 0, nil, false, exp},
+	}
+
+	for _, tt := range tests {
+		got := !tt.want
+
+		var err error
+		if tt.key == "" {
+			err = Segment(&got, path, tt.i, tt.opts...)
+		} else {
+			err = SubSeg(&got, path, tt.key, tt.i, tt.opts...)
+		}
+		if tt.ck(t, tt.name, err) {
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBhvrSegmentExt(t *testing.T) {
+	path := "/users/42.json/export/report.csv/price/3.5"
+
+	var id int
+	ext, err := SegmentExt(&id, path, 1)
+	if unx(t, "int", err) {
+		return
+	}
+
+	if id != 42 || ext != "json" {
+		t.Errorf(gwFmt, fmt.Sprint(id, ext), "42 json")
+	}
+
+	var name string
+	ext, err = SegmentExt(&name, path, 3)
+	if unx(t, "string", err) {
+		return
+	}
+
+	if name != "report" || ext != "csv" {
+		t.Errorf(gwFmt, name+" "+ext, "report csv")
+	}
+
+	var price float64
+	ext, err = SegmentExt(&price, path, -1)
+	if unx(t, "float", err) {
+		return
+	}
+
+	if price != 3.5 || ext != "" {
+		t.Errorf(gwFmt, fmt.Sprint(price, ext), "3.5 ")
+	}
+
+	if ext = New(path).Ext(); ext != "" {
+		t.Errorf(gwFmt, ext, "")
+	}
+
+	p := New("/export/report.csv")
+	if ext = p.Ext(); ext != "csv" {
+		t.Errorf(gwFmt, ext, "csv")
+	}
+
+	if ext = p.SegmentExt(&name, 1); unx(t, "parth", p.Err()) || ext != "csv" {
+		t.Errorf(gwFmt, ext, "csv")
+	}
+}
+
+func TestBhvrAffixes(t *testing.T) {
+	path := "/tickets/ticket-1234/users/@alice/repos/parth.git/tags/~v2"
+
+	tests := []struct {
+		name string
+		key  string
+		opts []Option
+		want any
+		ck   checkFunc
+	}{
+		{"no sign", "tickets", nil, 1234, unx},
+		{"lenient sign", "tickets", []Option{LenientSigns()}, -1234, unx},
+		{"prefix", "tickets", []Option{TrimPrefix("ticket-")}, 1234, unx},
+		{"prefix string", "users", []Option{TrimPrefix("@")}, "alice", unx},
+		{"prefix missing", "repos", []Option{TrimPrefix("@")}, "", exp},
+		{"suffix", "repos", []Option{TrimSuffix(".git")}, "parth", unx},
+		{"suffix missing", "users", []Option{TrimSuffix(".git")}, "", exp},
+		{"both", "tags", []Option{TrimPrefix("~v"), TrimSuffix("")}, 2, unx},
+		{"overlap", "users", []Option{TrimPrefix("@alice"), TrimSuffix("alice")}, "", exp},
+	}
+
+	for _, tt := range tests {
+		got := reflect.New(reflect.TypeOf(tt.want))
+		err := Sequent(got.Interface(), path, tt.key, tt.opts...)
+		if tt.ck(t, tt.name, err) {
+			continue
+		}
+
+		if got.Elem().Interface() != tt.want {
+			t.Errorf(gwxFmt, tt.name, got.Elem(), tt.want)
+		}
+	}
+
+	var id uint
+	if err := SegmentNumber(&id, path, 1, 0, TrimPrefix("ticket-")); unx(t, "number", err) {
+		return
+	}
+
+	if id != 1234 {
+		t.Errorf(gwFmt, id, 1234)
+	}
+
+	var scan struct{ ID uint }
+	if err := SegmentScan(path, 1, "ticket-{id:uint}", &scan); unx(t, "scan", err) {
+		return
+	}
+
+	if scan.ID != 1234 {
+		t.Errorf(gwFmt, scan.ID, 1234)
+	}
+}
+
+func TestBhvrUnicodeDigits(t *testing.T) {
+	path := "/ids/é-5/ｎ４２/٣.٥/bad\xff7"
+
+	tests := []struct {
+		name string
+		i    int
+		opts []Option
+		want any
+		err  error
+	}{
+		{"letter before sign", 1, nil, 5, nil},
+		{"full-width", 2, nil, 0, ErrDataUnparsable},
+		{"full-width norm", 2, []Option{NormalizeDigits()}, 42, nil},
+		{"arabic-indic norm", 3, []Option{NormalizeDigits()}, 3.5, nil},
+		{"invalid", 4, nil, 0, ErrUTF8Invalid},
+	}
+
+	for _, tt := range tests {
+		got := reflect.New(reflect.TypeOf(tt.want))
+		err := Segment(got.Interface(), path, tt.i, tt.opts...)
+		if !errors.Is(err, tt.err) {
+			t.Errorf(gwxFmt, tt.name, err, tt.err)
+			continue
+		}
+
+		if tt.err == nil && got.Elem().Interface() != tt.want {
+			t.Errorf(gwxFmt, tt.name, got.Elem(), tt.want)
+		}
+	}
+
+	nums, err := SegmentNumbers(path, 2, NormalizeDigits())
+	if unx(t, "numbers", err) {
+		return
+	}
+
+	if want := []string{"42"}; !reflect.DeepEqual(nums, want) {
+		t.Errorf(gwFmt, nums, want)
+	}
+}
+
+func TestBhvrKeyMatch(t *testing.T) {
+	path := "/api/User/42/Posts/7/8"
+
+	var id int
+	if err := Sequent(&id, path, "users"); err != ErrKeySegNotFound {
+		t.Errorf(gwFmt, err, ErrKeySegNotFound)
+	}
+
+	err := Sequent(&id, path, "users", KeyFoldASCII(), KeyAliases("user"))
+	if unx(t, "sequent", err) {
+		return
+	}
+
+	if id != 42 {
+		t.Errorf(gwFmt, id, 42)
+	}
+
+	s, err := SubSpan(path, "posts", 0, 2, KeyFold())
+	if unx(t, "subspan", err) {
+		return
+	}
+
+	if s != "/7/8" {
+		t.Errorf(gwFmt, s, "/7/8")
+	}
+
+	p := NewBySubSpan(path, "user", 0, 0, KeyFold())
+	p.SubSeg(&id, "posts", 1, KeyFold())
+	if s = p.SubSpan("POSTS", 0, 1, KeyFold()); unx(t, "parth", p.Err()) {
+		return
+	}
+
+	if id != 8 || s != "/7" {
+		t.Errorf(gwFmt, fmt.Sprint(id, s), "8 /7")
+	}
+}
+
+func TestBhvrTile(t *testing.T) {
+	path := "/tiles/osm/12/1205/1539.png"
+	tile := Tile{12, 1205, 1539}
+
+	tests := []struct {
+		name string
+		fn   func() (Tile, error)
+		want Tile
+		ck   checkFunc
+	}{
+		{"span", func() (Tile, error) { return SpanTile(path, 2) }, tile, unx},
+		{"span neg", func() (Tile, error) { return SpanTile(path, -3) }, tile, unx},
+		{"span short", func() (Tile, error) { return SpanTile(path, -2) }, Tile{}, exp},
+		{"span neg trailing slash", func() (Tile, error) { return SpanTile(path+"/", -3) }, tile, unx},
+		{"segment neg trailing slash", func() (Tile, error) {
+			var v Tile
+			err := Segment(&v, "/tiles/osm/12/1205/1539/", -3)
+			return v, err
+		}, tile, unx},
+		{"segment", func() (Tile, error) {
+			var v Tile
+			err := Segment(&v, path, 2)
+			return v, err
+		}, tile, unx},
+		{"sequent", func() (Tile, error) {
+			var v Tile
+			err := Sequent(&v, path, "osm")
+			return v, err
+		}, tile, unx},
+		{"parth", func() (Tile, error) {
+			p := New(path)
+			v := p.SpanTile(2)
+			return v, p.Err()
+		}, tile, unx},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.fn()
+		if tt.ck(t, tt.name, err) {
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.name, got, tt.want)
+		}
+	}
+
+	var ll LatLng
+	if err := Sequent(&ll, "/near/40.6892,-74.0445", "near"); unx(t, "latlng", err) {
+		return
+	}
+
+	if want := (LatLng{40.6892, -74.0445}); ll != want {
+		t.Errorf(gwFmt, ll, want)
+	}
+}
+
+type scanString string
+
+func (s *scanString) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*s = "<null>"
+	case string:
+		*s = scanString(src)
+	default:
+		return ErrUnknownType
+	}
+
+	return nil
+}
+
+type textScanner string
+
+func (s *textScanner) UnmarshalText(text []byte) error {
+	*s = textScanner(text)
+	return nil
+}
+
+func (s *textScanner) Scan(src any) error {
+	*s = "<scanned>"
+	return nil
+}
+
+type nullLike struct {
+	V     string
+	Valid bool
+}
+
+func (n *nullLike) Scan(src any) error {
+	s, ok := src.(string)
+	n.V, n.Valid = "custom:"+s, ok
+	return nil
+}
+
+type failScanner struct{}
+
+func (failScanner) Scan(src any) error {
+	return errors.New("scan failed")
+}
+
+func TestBhvrSQLNull(t *testing.T) {
+	path := "/users/id42/name/ann/since/2020-01-02"
+
+	t.Run("present", func(t *testing.T) {
+		var id sql.NullInt64
+		var name sql.NullString
+		var since sql.NullTime
+		var ss scanString
+
+		p := New(path)
+		p.Sequent(&id, "users")
+		p.Sequent(&name, "name")
+		p.Sequent(&since, "since")
+		p.Sequent(&ss, "name")
+		if unx(t, t.Name(), p.Err()) {
+			return
+		}
+
+		if want := (sql.NullInt64{Int64: 42, Valid: true}); id != want {
+			t.Errorf(gwFmt, id, want)
+		}
+
+		if want := (sql.NullString{String: "ann", Valid: true}); name != want {
+			t.Errorf(gwFmt, name, want)
+		}
+
+		want := sql.NullTime{Time: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC), Valid: true}
+		if !since.Time.Equal(want.Time) || !since.Valid {
+			t.Errorf(gwFmt, since, want)
+		}
+
+		if ss != "ann" {
+			t.Errorf(gwFmt, ss, "ann")
+		}
+	})
+
+	t.Run("look-alike", func(t *testing.T) {
+		var nl nullLike
+		if err := Sequent(&nl, path, "name"); unx(t, t.Name(), err) {
+			return
+		}
+
+		if want := (nullLike{V: "custom:ann", Valid: true}); nl != want {
+			t.Errorf(gwFmt, nl, want)
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		id := sql.NullInt64{Int64: 1, Valid: true}
+		var ss scanString
+
+		p := New(path)
+		p.Sequent(&id, "groups")
+		p.Segment(&ss, 8)
+		if unx(t, t.Name(), p.Err()) {
+			return
+		}
+
+		if id.Valid {
+			t.Errorf(gwFmt, id, sql.NullInt64{})
+		}
+
+		if ss != "<null>" {
+			t.Errorf(gwFmt, ss, "<null>")
+		}
+	})
+
+	t.Run("missing text", func(t *testing.T) {
+		var ts textScanner
+		if err := Segment(&ts, path, 8); !errors.Is(err, ErrFirstSegNotFound) {
+			t.Errorf(gwFmt, err, ErrFirstSegNotFound)
+		}
+
+		if err := Sequent(&ts, path, "groups"); !errors.Is(err, ErrKeySegNotFound) {
+			t.Errorf(gwFmt, err, ErrKeySegNotFound)
+		}
+
+		if ts != "" {
+			t.Errorf(gwFmt, ts, "")
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var b sql.NullBool
+		err := Sequent(&b, path, "name")
+		if exp(t, t.Name(), err) {
+			return
+		}
+
+		if b.Valid {
+			t.Errorf(gwFmt, b, sql.NullBool{})
+		}
+	})
+
+	t.Run("scan error", func(t *testing.T) {
+		var ss failScanner
+		err := Sequent(&ss, path, "name")
+		if !errors.Is(err, ErrDataUnparsable) {
+			t.Errorf(gwFmt, err, ErrDataUnparsable)
+		}
+	})
+}
+
+func TestBhvrDecimal(t *testing.T) {
+	path := "/invoices/42/amount/19.99/total/usd1234.5678"
+
+	tests := []struct {
+		name string
+		key  string
+		opts []Option
+		want Decimal
+		ck   checkFunc
+	}{
+		{"basic", "amount", nil, Decimal{1999, 2}, unx},
+		{"first valid", "total", nil, Decimal{12345678, 4}, unx},
+		{"strict", "total", []Option{Strict()}, Decimal{}, exp},
+		{"max scale", "total", []Option{MaxScale(2)}, Decimal{}, exp},
+	}
+
+	for _, tt := range tests {
+		var got Decimal
+		err := Sequent(&got, path, tt.key, tt.opts...)
+		if tt.ck(t, tt.name, err) {
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.name, got, tt.want)
+		}
+	}
+
+	t.Run("big", func(t *testing.T) {
+		var i big.Int
+		var f big.Float
+		var r big.Rat
+
+		p := New("/n/123456789012345678901234567890/f/1.5/r/0.1")
+		p.Sequent(&i, "n")
+		p.Sequent(&f, "f")
+		p.Sequent(&r, "r")
+		if unx(t, t.Name(), p.Err()) {
+			return
+		}
+
+		if got, want := i.String(), "123456789012345678901234567890"; got != want {
+			t.Errorf(gwFmt, got, want)
+		}
+
+		if got, want := f.String(), "1.5"; got != want {
+			t.Errorf(gwFmt, got, want)
+		}
+
+		if got, want := r.String(), "1/10"; got != want {
+			t.Errorf(gwFmt, got, want)
+		}
+
+		p.Segment(&i, 0)
+		exp(t, t.Name(), p.Err())
+	})
+
+	t.Run("complex", func(t *testing.T) {
+		var got complex128
+		err := Segment(&got, "/z/(1.5-2i)", 1, Strict())
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		if want := complex(1.5, -2); got != want {
+			t.Errorf(gwFmt, got, want)
+		}
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		var n int
+		exp(t, t.Name(), Segment(&n, "/id42", 0, Strict()))
+		unx(t, t.Name(), Segment(&n, "/42", 0, Strict()))
+	})
+}
+
 func TestBhvrParth(t *testing.T) {
 	t.Run("bySpan/segment", func(t *testing.T) {
 		p := NewBySpan("/zero/one/two/three", 1, 3)
@@ -251,6 +1172,44 @@ func applyToBoolTFunc(path, key string, i *int, want bool) func(*testing.T) {
 	}
 }
 
+func applyToComplex64TFunc(path, key string, i *int, want complex64) func(*testing.T) {
+	return func(t *testing.T) {
+		subj := subject(path, key)
+		if i != nil {
+			subj = subject(path, key, *i)
+		}
+
+		var got complex64
+		err := segSeqSubSeg(&got, path, key, i)
+		if unx(t, subj, err) {
+			return
+		}
+
+		if got != want {
+			t.Errorf(gwFmt, got, want)
+		}
+	}
+}
+
+func applyToComplex128TFunc(path, key string, i *int, want complex128) func(*testing.T) {
+	return func(t *testing.T) {
+		subj := subject(path, key)
+		if i != nil {
+			subj = subject(path, key, *i)
+		}
+
+		var got complex128
+		err := segSeqSubSeg(&got, path, key, i)
+		if unx(t, subj, err) {
+			return
+		}
+
+		if got != want {
+			t.Errorf(gwFmt, got, want)
+		}
+	}
+}
+
 func applyToFloat32TFunc(path, key string, i *int, want float32) func(*testing.T) {
 	return func(t *testing.T) {
 		subj := subject(path, key)
@@ -0,0 +1,410 @@
+package parth
+
+import (
+	"encoding"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SegmentScan locates the path segment indicated by index i, and unmarshals
+// it into dst according to the provided pattern. If the index is negative, the
+// negative count begins with the last segment.
+//
+// A pattern is made up of literal text and captures. Literal text must match
+// the segment exactly, and "{{" and "}}" are used for literal braces. A capture
+// is written as {name} or {name:type}. The value of each capture is
+// unmarshaled into the field of dst (which must be a pointer to a struct) that
+// has a matching "parth" tag, or otherwise a matching name (ignoring case).
+// Captures with no corresponding field are matched, but discarded. Options may
+// follow the name in a field's tag (e.g. `parth:"date,layout=2006-01-02"`);
+// see [Option] for the available tag options. The tag option
+// "oneof={value}|{value}" limits a string field to the listed values, as
+// [OneOf] does.
+//
+// Valid capture types are:
+//   - int, uint, float: The capture consists of only the number.
+//   - int:hex, int:oct, int:bin (and the same for uint): The capture consists
+//     of only the number in the indicated base (prefix optional).
+//   - uuid, ulid, ksuid, base62: The capture consists of only the
+//     identifier (see [UUID], [ULID], [KSUID], and [Base62]).
+//   - version: The capture consists of only the version (see [Version]).
+//   - version:{constraint}: The same as version, but the version must also
+//     satisfy the space-separated comparisons of the constraint (e.g.
+//     ">=1.2 <2"). Valid operators are =, !=, <, <=, >, and >=. The "version:"
+//     may be omitted (e.g. "{ver:>=1.2 <2}"). A prerelease version only
+//     satisfies a constraint that holds a prerelease of the same major,
+//     minor, and patch version (e.g. "2.0.0-rc1" does not satisfy "<2").
+//   - string (or none): The capture extends up to the following literal text,
+//     or to the end of the segment. An untyped capture must not be followed
+//     directly by another capture.
+//
+// For example, "{w:int}x{h:int}@{scale:int}x.{ext}" matches "800x600@2x.webp".
+func SegmentScan(path string, i int, pattern string, dst any, opts ...Option) error {
+	s, err := segmentToString(path, i)
+	if err != nil {
+		return err
+	}
+
+	return scanSegment(s, pattern, dst, makeConfig(opts))
+}
+
+type scanPart struct {
+	lit     string
+	name    string
+	typ     string
+	mod     string
+	capture bool
+	vers    []versionBound
+}
+
+func scanSegment(s, pattern string, dst any, c *config) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return ErrUnknownType
+	}
+	rv = rv.Elem()
+
+	parts, err := parseScanPattern(pattern)
+	if err != nil {
+		return err
+	}
+
+	for n, p := range parts {
+		if !p.capture {
+			if !strings.HasPrefix(s, p.lit) {
+				return ErrPatternMismatch
+			}
+
+			s = s[len(p.lit):]
+			continue
+		}
+
+		var next string
+		if n+1 < len(parts) {
+			next = parts[n+1].lit
+		}
+
+		l := captureLen(s, p, next)
+		if l == 0 {
+			return ErrPatternMismatch
+		}
+
+		if p.vers != nil {
+			v, _ := parseVersion(s[:l])
+			if !versionMatches(v, p.vers) {
+				return ErrPatternMismatch
+			}
+		}
+
+		if err := assignCapture(rv, p, s[:l], c); err != nil {
+			return err
+		}
+
+		s = s[l:]
+	}
+
+	if s != "" {
+		return ErrPatternMismatch
+	}
+
+	return nil
+}
+
+func parseScanPattern(pattern string) ([]scanPart, error) {
+	var parts []scanPart
+	var lit strings.Builder
+
+	for n := 0; n < len(pattern); n++ {
+		c := pattern[n]
+
+		if (c == '{' || c == '}') && n+1 < len(pattern) && pattern[n+1] == c {
+			lit.WriteByte(c)
+			n++
+			continue
+		}
+
+		if c == '}' {
+			return nil, ErrPatternInvalid
+		}
+
+		if c != '{' {
+			lit.WriteByte(c)
+			continue
+		}
+
+		end := strings.IndexByte(pattern[n:], '}')
+		if end < 0 {
+			return nil, ErrPatternInvalid
+		}
+
+		p, ok := parseScanCapture(pattern[n+1 : n+end])
+		if !ok {
+			return nil, ErrPatternInvalid
+		}
+
+		if lit.Len() > 0 {
+			parts = append(parts, scanPart{lit: lit.String()})
+			lit.Reset()
+		} else if len(parts) > 0 && parts[len(parts)-1].typ == "string" {
+			return nil, ErrPatternInvalid
+		}
+
+		parts = append(parts, p)
+		n += end
+	}
+
+	if lit.Len() > 0 {
+		parts = append(parts, scanPart{lit: lit.String()})
+	}
+
+	return parts, nil
+}
+
+func parseScanCapture(s string) (scanPart, bool) {
+	p := scanPart{capture: true, typ: "string"}
+
+	p.name, s, _ = strings.Cut(s, ":")
+	if p.name == "" {
+		return p, false
+	}
+
+	if s == "" {
+		return p, true
+	}
+
+	if strings.IndexByte("<>=!", s[0]) >= 0 {
+		s = "version:" + s
+	}
+
+	p.typ, p.mod, _ = strings.Cut(s, ":")
+
+	switch p.typ {
+	case "int", "uint":
+		return p, p.mod == "" || scanModBase(p.mod) > 0
+	case "float", "string", "uuid", "ulid", "ksuid", "base62":
+		return p, p.mod == ""
+	case "version":
+		if p.mod == "" {
+			return p, true
+		}
+
+		var ok bool
+		p.vers, ok = parseVersionConstraint(p.mod)
+		return p, ok
+	}
+
+	return p, false
+}
+
+func scanModBase(mod string) int {
+	switch mod {
+	case "hex":
+		return 16
+	case "oct":
+		return 8
+	case "bin":
+		return 2
+	}
+
+	return 0
+}
+
+// captureLen returns the length of the capture at the start of s, or 0 if no
+// capture is found. The literal text that follows the capture is provided as
+// next.
+func captureLen(s string, p scanPart, next string) int {
+	if s == "" {
+		return 0
+	}
+
+	base := scanModBase(p.mod)
+	if base == 0 {
+		base = 10
+	}
+
+	switch p.typ {
+	case "int":
+		n := 0
+		if s[0] == '-' || s[0] == '+' {
+			n++
+		}
+
+		if l := intLen(s[n:], base); l > 0 {
+			return n + l
+		}
+
+		return 0
+
+	case "uint":
+		return intLen(s, base)
+
+	case "float":
+		return floatLen(s)
+
+	case "uuid":
+		return idLen(s, 36, new(UUID))
+
+	case "ulid":
+		return idLen(s, 26, new(ULID))
+
+	case "ksuid":
+		return idLen(s, 27, new(KSUID))
+
+	case "version":
+		_, n := parseVersion(s)
+		return n
+
+	case "base62":
+		n := 0
+		for n < len(s) && base62Digit(s[n]) >= 0 {
+			n++
+		}
+
+		return n
+	}
+
+	if next == "" {
+		return len(s)
+	}
+
+	if l := strings.Index(s, next); l > 0 {
+		return l
+	}
+
+	return 0
+}
+
+// idLen returns l if the first l bytes of s are a valid identifier, otherwise
+// 0 is returned.
+func idLen(s string, l int, id encoding.TextUnmarshaler) int {
+	if len(s) < l || id.UnmarshalText([]byte(s[:l])) != nil {
+		return 0
+	}
+
+	return l
+}
+
+func assignCapture(rv reflect.Value, p scanPart, s string, c *config) error {
+	fv, tagOpts, ok := scanField(rv, p.name)
+	if !ok {
+		return nil
+	}
+
+	opts, oneOf, err := tagOptions(tagOpts)
+	if err != nil {
+		return err
+	}
+
+	v := fv.Addr().Interface()
+	if oneOf != nil {
+		if fv.Kind() != reflect.String {
+			return ErrTagInvalid
+		}
+
+		v = &Enum{
+			vals: oneOf,
+			set: func(n int) {
+				fv.SetString(oneOf[n])
+			},
+		}
+	}
+
+	if base := scanModBase(p.mod); base > 0 {
+		opts = append(opts, Base(base))
+	}
+
+	if len(opts) > 0 {
+		cc := *c
+		for _, opt := range opts {
+			opt(&cc)
+		}
+		c = &cc
+	}
+
+	return unmarshalSegment(v, s, c)
+}
+
+// scanField returns the field of rv that corresponds to the named capture,
+// along with any options found in the field's tag.
+func scanField(rv reflect.Value, name string) (reflect.Value, string, bool) {
+	rt := rv.Type()
+
+	for n := 0; n < rt.NumField(); n++ {
+		f := rt.Field(n)
+		if !f.IsExported() {
+			continue
+		}
+
+		tagName, tagOpts, _ := strings.Cut(f.Tag.Get("parth"), ",")
+		if tagName == name || tagName == "" && strings.EqualFold(f.Name, name) {
+			return rv.Field(n), tagOpts, true
+		}
+	}
+
+	return reflect.Value{}, "", false
+}
+
+// tagOptions converts the comma-separated options of a "parth" struct tag into
+// [Option] values. The values of the "oneof" option (separated by "|") are
+// returned separately, as they describe an [Enum] rather than an [Option].
+func tagOptions(s string) ([]Option, []string, error) {
+	var opts []Option
+	var layouts, oneOf []string
+
+	for s != "" {
+		var opt string
+		opt, s, _ = strings.Cut(s, ",")
+
+		k, v, _ := strings.Cut(opt, "=")
+
+		switch k {
+		case "layout":
+			layouts = append(layouts, v)
+
+		case "loc":
+			loc, err := time.LoadLocation(v)
+			if err != nil {
+				return nil, nil, ErrTagInvalid
+			}
+
+			opts = append(opts, Location(loc))
+
+		case "oneof":
+			if v == "" {
+				return nil, nil, ErrTagInvalid
+			}
+
+			oneOf = strings.Split(v, "|")
+
+		case "enc":
+			enc, ok := byteEncodingNames[v]
+			if !ok {
+				return nil, nil, ErrTagInvalid
+			}
+
+			opts = append(opts, Encoding(enc))
+
+		case "len":
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				return nil, nil, ErrTagInvalid
+			}
+
+			opts = append(opts, ByteLen(n))
+
+		case "fold":
+			opts = append(opts, FoldCase())
+
+		default:
+			return nil, nil, ErrTagInvalid
+		}
+	}
+
+	if len(layouts) > 0 {
+		opts = append(opts, Layouts(layouts...))
+	}
+
+	return opts, oneOf, nil
+}
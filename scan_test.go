@@ -0,0 +1,157 @@
+package parth
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type thumb struct {
+	W     int
+	H     uint16
+	Scale int `parth:"scale"`
+	Ext   string
+}
+
+func TestBhvrSegmentScan(t *testing.T) {
+	path := "/img/800x600@2x.webp/reg-0x1F"
+
+	t.Run("thumb", func(t *testing.T) {
+		var got thumb
+		err := SegmentScan(path, 1, "{w:int}x{h:int}@{scale:int}x.{ext}", &got)
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		want := thumb{W: 800, H: 600, Scale: 2, Ext: "webp"}
+		if got != want {
+			t.Errorf(gwFmt, got, want)
+		}
+	})
+
+	t.Run("discard", func(t *testing.T) {
+		var got thumb
+		err := SegmentScan(path, 1, "{w:int}x{_:int}@{scale:int}x.{ext}", &got)
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		want := thumb{W: 800, Scale: 2, Ext: "webp"}
+		if got != want {
+			t.Errorf(gwFmt, got, want)
+		}
+	})
+
+	t.Run("hex", func(t *testing.T) {
+		var got struct{ Reg uint8 }
+		err := SegmentScan(path, -1, "reg-{reg:uint:hex}", &got)
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		if got.Reg != 31 {
+			t.Errorf(gwFmt, got.Reg, 31)
+		}
+	})
+
+	t.Run("tag", func(t *testing.T) {
+		var got struct {
+			Day  time.Time `parth:"day,layout=20060102"`
+			Name string
+		}
+		err := SegmentScan("/log/20241017-app.txt", 1, "{day}-{name}.txt", &got)
+		if unx(t, t.Name(), err) {
+			return
+		}
+
+		want := time.Date(2024, 10, 17, 0, 0, 0, 0, time.UTC)
+		if !got.Day.Equal(want) || got.Name != "app" {
+			t.Errorf(gwFmt, got, want)
+		}
+
+		var bad struct {
+			Day time.Time `parth:"day,format=20060102"`
+		}
+		err = SegmentScan("/log/20241017", 1, "{day}", &bad)
+		if !errors.Is(err, ErrTagInvalid) {
+			t.Errorf(gwFmt, err, ErrTagInvalid)
+		}
+	})
+
+	t.Run("version", func(t *testing.T) {
+		var got struct{ Ver Version }
+
+		tests := []struct {
+			path    string
+			pattern string
+			want    Version
+			err     error
+		}{
+			{"/api/v1.4", "v{ver:>=1.2 <2}", Version{Major: 1, Minor: 4}, nil},
+			{"/api/v2", "v{ver:>=1.2 <2}", Version{}, ErrPatternMismatch},
+			{"/api/v1.1", "v{ver:>=1.2 <2}", Version{}, ErrPatternMismatch},
+			{"/api/v2.0.0-rc1", "v{ver:>=1.2 <2}", Version{}, ErrPatternMismatch},
+			{"/api/v1.4.0-rc1", "v{ver:>=1.2 <2}", Version{}, ErrPatternMismatch},
+			{"/api/v1.4.0-rc2", "v{ver:>=1.4.0-rc1 <2}", Version{Major: 1, Minor: 4, Pre: "rc2"}, nil},
+			{"/api/1.4.2-rc1+b5", "{ver:version}", Version{Major: 1, Minor: 4, Patch: 2, Pre: "rc1", Build: "b5"}, nil},
+			{"/api/v1", "v{ver:version:!=1}", Version{}, ErrPatternMismatch},
+			{"/api/v1", "v{ver:~1}", Version{}, ErrPatternInvalid},
+			{"/api/v1", "v{ver:version:1}", Version{}, ErrPatternInvalid},
+		}
+
+		for _, tt := range tests {
+			got.Ver = Version{}
+			err := SegmentScan(tt.path, 1, tt.pattern, &got)
+			if !errors.Is(err, tt.err) {
+				t.Errorf(gwxFmt, tt.path, err, tt.err)
+				continue
+			}
+
+			if got.Ver != tt.want {
+				t.Errorf(gwxFmt, tt.path, got.Ver, tt.want)
+			}
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			pattern string
+			dst     any
+			want    error
+		}{
+			{"literal", "{w:int}X{h:int}@{scale:int}x.{ext}", &thumb{}, ErrPatternMismatch},
+			{"type", "{w:int}x{h:uint}@{scale:float}x.{ext:int}", &thumb{}, ErrPatternMismatch},
+			{"trailing", "{w:int}x{h:int}", &thumb{}, ErrPatternMismatch},
+			{"adjacent", "{w}{h:int}", &thumb{}, ErrPatternInvalid},
+			{"unclosed", "{w:int", &thumb{}, ErrPatternInvalid},
+			{"unknown", "{w:complex}", &thumb{}, ErrPatternInvalid},
+			{"dst", "{w}", thumb{}, ErrUnknownType},
+		}
+
+		for _, tt := range tests {
+			err := SegmentScan(path, 1, tt.pattern, tt.dst)
+			if !errors.Is(err, tt.want) {
+				t.Errorf(gwxFmt, tt.name, err, tt.want)
+			}
+		}
+	})
+}
+
+func TestUnitParseScanPattern(t *testing.T) {
+	got, err := parseScanPattern("{{{a}}}-{b:int:hex}")
+	if unx(t, t.Name(), err) {
+		return
+	}
+
+	want := []scanPart{
+		{lit: "{"},
+		{name: "a", typ: "string", capture: true},
+		{lit: "}-"},
+		{name: "b", typ: "int", mod: "hex", capture: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf(gwFmt, got, want)
+	}
+}
@@ -1,5 +1,7 @@
 package parth
 
+import "strings"
+
 func segStartIndexFromStart(path string, seg int) (int, bool) {
 	if seg < 0 {
 		return 0, false
@@ -85,34 +87,102 @@ func segEndIndexFromEnd(path string, seg int) (int, bool) {
 	return 0, false
 }
 
-func segIndexByKey(path, key string) (int, bool) { //nolint
-	if path == "" || key == "" {
-		return 0, false
+// segIndexByKey returns the start index of the first segment that matches key,
+// or any of the key aliases of c, along with the number of segments spanned by
+// the matching key. If a key contains slashes, it is matched against an equal
+// number of consecutive segments (e.g. the key "10.0.0.0/8" spans two
+// segments).
+func segIndexByKey(path, key string, c *config) (int, int, bool) {
+	if path == "" {
+		return 0, 0, false
 	}
 
 	for n := 0; n < len(path); n++ {
 		si, ok := segStartIndexFromStart(path, n)
 		if !ok {
-			return 0, false
+			return 0, 0, false
+		}
+
+		rest := path[si:]
+		unrooted := n == 0 && path[0] != '/'
+
+		if segs, ok := keysSegMatch(rest, key, unrooted, c); ok {
+			return si, segs, true
 		}
+	}
 
-		if len(path[si:]) == len(key)+1 {
-			if path[si+1:] == key {
-				return si, true
-			}
+	return 0, 0, false
+}
 
-			return 0, false
+// keysSegMatch reports whether the segments at the start of path match key or
+// any of the key aliases of c, along with the number of segments spanned by
+// the matching key.
+func keysSegMatch(path, key string, unrooted bool, c *config) (int, bool) {
+	for n := -1; n < len(c.keyAliases); n++ {
+		k := key
+		if n >= 0 {
+			k = c.keyAliases[n]
 		}
 
-		tmpEI, ok := segStartIndexFromStart(path[si:], 1)
+		if k == "" {
+			continue
+		}
+
+		segs := keySegCount(k)
+
+		ei, ok := segStartIndexFromStart(path, segs)
 		if !ok {
-			return 0, false
+			ei = len(path)
 		}
 
-		if path[si+1:tmpEI+si] == key || n == 0 && path[0] != '/' && path[si:tmpEI+si] == key {
-			return si, true
+		if keySegMatch(path[:ei], k, unrooted, c.keyFold) {
+			return segs, true
 		}
 	}
 
 	return 0, false
 }
+
+func keySegMatch(seg, key string, unrooted bool, fold func(string, string) bool) bool {
+	if !unrooted {
+		if len(seg) == 0 || seg[0] != '/' {
+			return false
+		}
+		seg = seg[1:]
+	}
+
+	if fold != nil {
+		return fold(seg, key)
+	}
+
+	return seg == key
+}
+
+// asciiEqualFold reports whether s and t are equal when ASCII letters are
+// compared case-insensitively.
+func asciiEqualFold(s, t string) bool {
+	if len(s) != len(t) {
+		return false
+	}
+
+	for n := 0; n < len(s); n++ {
+		if lower(s[n]) != lower(t[n]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func lower(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + ('a' - 'A')
+	}
+
+	return c
+}
+
+// keySegCount returns the number of segments spanned by key.
+func keySegCount(key string) int {
+	return strings.Count(key, "/") + 1
+}
@@ -152,11 +152,15 @@ func TestUnitSegIndexByKey(t *testing.T) {
 		{"bad", "/ba/d/", 0, false},
 		{"11", "/4/56/11/", 5, true},
 		{"", "/4/56/11/", 0, false},
+		{"10.0.0.0/8", "/n/10.0.0.0/8/h", 2, true},
+		{"10.0.0.0/8", "/n/10.0.0.0/8", 2, true},
+		{"10.0.0.0/8", "/n/10.0.0.0/80", 0, false},
+		{"a/b", "a/b/c", 0, true},
 		{"t", "", 0, false},
 	}
 
 	for _, tt := range tests {
-		got, okGot := segIndexByKey(tt.s, tt.k)
+		got, _, okGot := segIndexByKey(tt.s, tt.k, &defaultConfig)
 		if okGot != tt.okWant {
 			t.Errorf(gwxFmt, tt.s, okGot, tt.okWant)
 			continue
@@ -167,3 +171,36 @@ func TestUnitSegIndexByKey(t *testing.T) {
 		}
 	}
 }
+
+func TestUnitSegIndexByKeyConfig(t *testing.T) {
+	var tests = []struct {
+		s      string
+		k      string
+		opts   []Option
+		want   int
+		segs   int
+		okWant bool
+	}{
+		{"/a/Users/42", "users", nil, 0, 0, false},
+		{"/a/Users/42", "users", []Option{KeyFoldASCII()}, 2, 1, true},
+		{"/a/ÜSERS/42", "üsers", []Option{KeyFoldASCII()}, 0, 0, false},
+		{"/a/ÜSERS/42", "üsers", []Option{KeyFold()}, 2, 1, true},
+		{"/a/user/42", "users", []Option{KeyAliases("user")}, 2, 1, true},
+		{"/user/1/users/2", "users", []Option{KeyAliases("user")}, 0, 1, true},
+		{"/a/v1/users/42", "users", []Option{KeyAliases("V1/Users"), KeyFold()}, 2, 2, true},
+		{"user/42", "", []Option{KeyAliases("user")}, 0, 1, true},
+		{"/a/b", "", nil, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, segs, okGot := segIndexByKey(tt.s, tt.k, makeConfig(tt.opts))
+		if okGot != tt.okWant {
+			t.Errorf(gwxFmt, tt.s, okGot, tt.okWant)
+			continue
+		}
+
+		if got != tt.want || segs != tt.segs {
+			t.Errorf(gwxFmt, tt.s, []int{got, segs}, []int{tt.want, tt.segs})
+		}
+	}
+}
@@ -1,158 +1,592 @@
 package parth
 
 import (
+	"database/sql"
+	"encoding"
+	"errors"
+	"flag"
+	"math"
+	"math/big"
+	"net/netip"
+	"reflect"
 	"strconv"
+	"strings"
+	"time"
 	"unicode"
+	"unicode/utf8"
 )
 
-func segmentToBool(path string, i int) (bool, error) {
-	s, err := segmentToString(path, i)
+func unmarshalSegment(v any, s string, c *config) error {
+	var err error
+
+	switch v := v.(type) {
+	case *bool:
+		*v, err = stringToBool(s, c)
+
+	case *complex64:
+		var x complex128
+		x, err = stringToComplexN(s, 64, c)
+		*v = complex64(x)
+
+	case *complex128:
+		*v, err = stringToComplexN(s, 128, c)
+
+	case *float32:
+		var f float64
+		f, err = stringToFloatN(s, 32, c)
+		*v = float32(f)
+
+	case *float64:
+		*v, err = stringToFloatN(s, 64, c)
+
+	case *int:
+		var n int64
+		n, err = stringToIntN(s, 0, c)
+		*v = int(n)
+
+	case *int16:
+		var n int64
+		n, err = stringToIntN(s, 16, c)
+		*v = int16(n)
+
+	case *int32:
+		var n int64
+		n, err = stringToIntN(s, 32, c)
+		*v = int32(n)
+
+	case *int64:
+		*v, err = stringToIntN(s, 64, c)
+
+	case *int8:
+		var n int64
+		n, err = stringToIntN(s, 8, c)
+		*v = int8(n)
+
+	case *string:
+		*v = s
+
+	case *[]byte:
+		*v, err = stringToBytes(s, c)
+
+	case *uint:
+		var n uint64
+		n, err = stringToUintN(s, 0, c)
+		*v = uint(n)
+
+	case *uint16:
+		var n uint64
+		n, err = stringToUintN(s, 16, c)
+		*v = uint16(n)
+
+	case *uint32:
+		var n uint64
+		n, err = stringToUintN(s, 32, c)
+		*v = uint32(n)
+
+	case *uint64:
+		*v, err = stringToUintN(s, 64, c)
+
+	case *uint8:
+		var n uint64
+		n, err = stringToUintN(s, 8, c)
+		*v = uint8(n)
+
+	case *netip.Addr:
+		*v, err = stringToAddr(s)
+
+	case *netip.AddrPort:
+		*v, err = stringToAddrPort(s)
+
+	case *netip.Prefix:
+		*v, err = stringToPrefix(s)
+
+	case *Decimal:
+		*v, err = stringToDecimal(s, c)
+
+	case *big.Int:
+		err = stringToBigInt(v, s, c)
+
+	case *big.Float:
+		err = stringToBigFloat(v, s, c)
+
+	case *big.Rat:
+		err = stringToBigRat(v, s, c)
+
+	case *time.Time:
+		*v, err = stringToTime(s, c)
+
+	case *time.Duration:
+		*v, err = stringToDuration(s, c)
+
+	case *sql.NullBool:
+		v.Bool, err = stringToBool(s, c)
+		v.Valid = err == nil
+
+	case *sql.NullByte:
+		var u uint64
+		u, err = stringToUintN(s, 8, c)
+		v.Byte, v.Valid = byte(u), err == nil
+
+	case *sql.NullFloat64:
+		v.Float64, err = stringToFloatN(s, 64, c)
+		v.Valid = err == nil
+
+	case *sql.NullInt16:
+		var n int64
+		n, err = stringToIntN(s, 16, c)
+		v.Int16, v.Valid = int16(n), err == nil
+
+	case *sql.NullInt32:
+		var n int64
+		n, err = stringToIntN(s, 32, c)
+		v.Int32, v.Valid = int32(n), err == nil
+
+	case *sql.NullInt64:
+		v.Int64, err = stringToIntN(s, 64, c)
+		v.Valid = err == nil
+
+	case *sql.NullString:
+		v.String, v.Valid = s, true
+
+	case *sql.NullTime:
+		v.Time, err = stringToTime(s, c)
+		v.Valid = err == nil
+
+	case *Enum:
+		err = v.unmarshal(s, c.fold)
+
+	case *JSONValue:
+		err = v.unmarshal(s, c)
+
+	case encoding.TextUnmarshaler:
+		err = v.UnmarshalText([]byte(s))
+
+	case flag.Value:
+		err = v.Set(s)
+
+	case sql.Scanner:
+		err = scanSQL(v, s, c)
+
+	default:
+		err = ErrUnknownType
+	}
+
+	return err
+}
+
+var (
+	boolTrueWords  = []string{"true", "t", "1", "yes", "y", "on", "enabled"}
+	boolFalseWords = []string{"false", "f", "0", "no", "n", "off", "disabled"}
+)
+
+func stringToBool(s string, c *config) (bool, error) {
+	if hasWordFold(boolTrueWords, s) || hasWordFold(c.trueWords, s) {
+		return true, nil
+	}
+
+	if hasWordFold(boolFalseWords, s) || hasWordFold(c.falseWords, s) {
+		return false, nil
+	}
+
+	return false, ErrDataUnparsable
+}
+
+func hasWordFold(words []string, s string) bool {
+	for _, w := range words {
+		if strings.EqualFold(w, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func stringToComplexN(ss string, size int, c *config) (complex128, error) {
+	ss, err := numericString(ss, c)
 	if err != nil {
-		return false, err
+		return 0, err
+	}
+
+	s, ok := firstComplexFromString(ss, c.lenientSigns)
+	if !ok || c.strict && s != ss && "("+s+")" != ss {
+		return 0, ErrDataUnparsable
 	}
 
-	v, err := strconv.ParseBool(s)
+	v, err := strconv.ParseComplex(s, size)
 	if err != nil {
-		return false, ErrDataUnparsable
+		return 0, parseError(err)
 	}
 
 	return v, nil
 }
 
-func segmentToFloatN(path string, i, size int) (float64, error) {
-	ss, err := segmentToString(path, i)
+func stringToFloatN(ss string, size int, c *config) (float64, error) {
+	ss, err := numericString(ss, c)
 	if err != nil {
 		return 0.0, err
 	}
 
-	s, ok := firstFloatFromString(ss)
-	if !ok {
-		return 0.0, err
+	s, ok := firstFloatFromString(ss, c.lenientSigns)
+	if !ok || c.strict && s != ss {
+		return 0.0, ErrDataUnparsable
 	}
 
 	v, err := strconv.ParseFloat(s, size)
 	if err != nil {
-		return 0.0, ErrDataUnparsable
+		if !c.saturate || !errors.Is(err, strconv.ErrRange) {
+			return 0.0, parseError(err)
+		}
+
+		max := math.MaxFloat64
+		if size == 32 {
+			max = math.MaxFloat32
+		}
+
+		v = math.Copysign(max, v)
 	}
 
 	return v, nil
 }
 
-func segmentToIntN(path string, i, size int) (int64, error) {
-	ss, err := segmentToString(path, i)
+func stringToAddr(s string) (netip.Addr, error) {
+	v, err := netip.ParseAddr(s)
 	if err != nil {
-		return 0, err
+		return netip.Addr{}, ErrDataUnparsable
 	}
 
-	s, ok := firstIntFromString(ss)
-	if !ok {
-		return 0, ErrDataUnparsable
+	return v, nil
+}
+
+func stringToAddrPort(s string) (netip.AddrPort, error) {
+	v, err := netip.ParseAddrPort(s)
+	if err != nil {
+		return netip.AddrPort{}, ErrDataUnparsable
+	}
+
+	return v, nil
+}
+
+func stringToPrefix(s string) (netip.Prefix, error) {
+	for _, esc := range []string{"%2F", "%2f"} {
+		if n := strings.Index(s, esc); n >= 0 {
+			s = s[:n] + "/" + s[n+len(esc):]
+			break
+		}
 	}
 
-	v, err := strconv.ParseInt(s, 10, size)
+	v, err := netip.ParsePrefix(s)
 	if err != nil {
-		return 0, ErrDataUnparsable
+		return netip.Prefix{}, ErrDataUnparsable
 	}
 
 	return v, nil
 }
 
-func segmentToString(path string, i int) (string, error) {
-	j := i + 1
-	if i < 0 {
-		i--
+func stringToDuration(s string, c *config) (time.Duration, error) {
+	if c.extDur {
+		return parseExtDuration(s)
 	}
 
-	s, err := Span(path, i, j)
+	v, err := time.ParseDuration(s)
 	if err != nil {
-		return "", err
+		return 0, ErrDataUnparsable
 	}
 
-	if s[0] == '/' {
+	return v, nil
+}
+
+func stringToTime(s string, c *config) (time.Time, error) {
+	for _, layout := range c.layouts {
+		switch layout {
+		case LayoutUnix, LayoutUnixMilli:
+			n, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				continue
+			}
+
+			if layout == LayoutUnix {
+				return time.Unix(n, 0).In(c.loc), nil
+			}
+
+			return time.UnixMilli(n).In(c.loc), nil
+
+		default:
+			t, err := time.ParseInLocation(layout, s, c.loc)
+			if err != nil {
+				continue
+			}
+
+			return t, nil
+		}
+	}
+
+	return time.Time{}, ErrDataUnparsable
+}
+
+// spanToTime converts a span of year, month, day, and hour segments into a
+// time.Time.
+func spanToTime(s string, c *config) (time.Time, error) {
+	if s != "" && s[0] == '/' {
 		s = s[1:]
 	}
 
-	return s, nil
+	vs := [4]int{0, 1, 1, 0}
+	maxs := [4]int{9999, 12, 31, 23}
+
+	n := 0
+	for ; s != "" && n < len(vs); n++ {
+		var seg string
+		seg, s, _ = strings.Cut(s, "/")
+
+		if seg == "" || strings.Trim(seg, "0123456789") != "" {
+			return time.Time{}, ErrDataUnparsable
+		}
+
+		v, err := strconv.Atoi(seg)
+		if err != nil {
+			return time.Time{}, parseError(err)
+		}
+
+		if v > maxs[n] || n > 0 && n < 3 && v == 0 {
+			return time.Time{}, ErrOutOfRange
+		}
+
+		vs[n] = v
+	}
+
+	if n == 0 || s != "" {
+		return time.Time{}, ErrDataUnparsable
+	}
+
+	t := time.Date(vs[0], time.Month(vs[1]), vs[2], vs[3], 0, 0, 0, c.loc)
+	if t.Day() != vs[2] {
+		return time.Time{}, ErrOutOfRange
+	}
+
+	return t, nil
 }
 
-func segmentToUintN(path string, i, size int) (uint64, error) {
-	ss, err := segmentToString(path, i)
+// leadingDigitSegs returns the span of up to max leading segments of s that
+// consist of only digits.
+func leadingDigitSegs(s string, max int) string {
+	end := 0
+
+	for ct := 0; ct < max && end < len(s); ct++ {
+		n := end
+		if s[n] == '/' {
+			n++
+		}
+
+		m := n
+		for m < len(s) && isDecDigit(s[m]) {
+			m++
+		}
+
+		if m == n || m < len(s) && s[m] != '/' {
+			break
+		}
+
+		end = m
+	}
+
+	return s[:end]
+}
+
+func stringToBigInt(v *big.Int, ss string, c *config) error {
+	ss, err := numericString(ss, c)
 	if err != nil {
-		return 0, err
+		return err
 	}
 
-	s, ok := firstUintFromString(ss)
-	if !ok {
-		return 0, ErrDataUnparsable
+	s, ok := firstIntFromStringBase(ss, c.base, c.lenientSigns)
+	if !ok || c.strict && s != ss {
+		return ErrDataUnparsable
 	}
 
-	v, err := strconv.ParseUint(s, 10, size)
-	if err != nil {
-		return 0, ErrDataUnparsable
+	s, base := intLiteralBase(s, c.base)
+
+	if _, ok := v.SetString(s, base); !ok {
+		return ErrDataUnparsable
 	}
 
-	return v, nil
+	return nil
 }
 
-func subSegToBool(path, key string, i int) (bool, error) {
-	s, err := subSegToString(path, key, i)
+func stringToBigFloat(v *big.Float, ss string, c *config) error {
+	ss, err := numericString(ss, c)
 	if err != nil {
-		return false, err
+		return err
 	}
 
-	v, err := strconv.ParseBool(s)
-	if err != nil {
-		return false, ErrDataUnparsable
+	s, ok := firstFloatFromString(ss, c.lenientSigns)
+	if !ok || c.strict && s != ss {
+		return ErrDataUnparsable
 	}
 
-	return v, nil
+	if _, _, err := v.Parse(s, 0); err != nil {
+		return ErrDataUnparsable
+	}
+
+	return nil
 }
 
-func subSegToFloatN(path, key string, i, size int) (float64, error) {
-	ss, err := subSegToString(path, key, i)
+func stringToBigRat(v *big.Rat, ss string, c *config) error {
+	ss, err := numericString(ss, c)
 	if err != nil {
-		return 0.0, err
+		return err
 	}
 
-	s, ok := firstFloatFromString(ss)
-	if !ok {
-		return 0.0, ErrDataUnparsable
+	s, ok := firstFloatFromString(ss, c.lenientSigns)
+	if !ok || c.strict && s != ss {
+		return ErrDataUnparsable
 	}
 
-	v, err := strconv.ParseFloat(s, size)
-	if err != nil {
-		return 0.0, ErrDataUnparsable
+	if strings.IndexByte(s, '_') >= 0 && intPrefixLen(unsigned(s), 0) == 0 {
+		s = strings.ReplaceAll(s, "_", "")
 	}
 
-	return v, nil
+	if _, ok := v.SetString(s); !ok {
+		return ErrDataUnparsable
+	}
+
+	return nil
 }
 
-func subSegToIntN(path, key string, i, size int) (int64, error) {
-	ss, err := subSegToString(path, key, i)
+func stringToIntN(ss string, size int, c *config) (int64, error) {
+	ss, err := numericString(ss, c)
 	if err != nil {
 		return 0, err
 	}
 
-	s, ok := firstIntFromString(ss)
-	if !ok {
+	if c.units != nil {
+		return unitsToIntN(ss, size, c)
+	}
+
+	s, ok := firstIntFromStringBase(ss, c.base, c.lenientSigns)
+	if !ok || c.strict && s != ss {
 		return 0, ErrDataUnparsable
 	}
 
-	v, err := strconv.ParseInt(s, 10, size)
+	s, base := intLiteralBase(s, c.base)
+
+	v, err := strconv.ParseInt(s, base, size)
+	if err != nil && (!c.saturate || !errors.Is(err, strconv.ErrRange)) {
+		return 0, parseError(err)
+	}
+
+	return v, nil
+}
+
+func stringToUintN(ss string, size int, c *config) (uint64, error) {
+	ss, err := numericString(ss, c)
 	if err != nil {
+		return 0, err
+	}
+
+	if c.units != nil {
+		return unitsToUintN(ss, size, c)
+	}
+
+	s, ok := firstUintFromStringBase(ss, c.base, c.lenientSigns)
+	if !ok || c.strict && s != ss {
 		return 0, ErrDataUnparsable
 	}
 
+	s, base := intLiteralBase(s, c.base)
+
+	v, err := strconv.ParseUint(s, base, size)
+	if err != nil && (!c.saturate || !errors.Is(err, strconv.ErrRange)) {
+		return 0, parseError(err)
+	}
+
 	return v, nil
 }
 
-func subSegToString(path, key string, i int) (string, error) {
-	ki, ok := segIndexByKey(path, key)
+// parseError converts an error returned by strconv into an error that is
+// identifiable as one of the Err{Name} values.
+func parseError(err error) error {
+	if errors.Is(err, strconv.ErrRange) {
+		return &wrapError{ErrOutOfRange, err}
+	}
+
+	return ErrDataUnparsable
+}
+
+// missingSegment handles err when it indicates that the segment which would be
+// unmarshaled into v does not exist. A v that is a [sql.Scanner] is scanned as
+// NULL (e.g. a [sql.NullInt64] is left with Valid set to false), and no error
+// is returned. A v that is also an [encoding.TextUnmarshaler] or [flag.Value]
+// is not unmarshaled as a scanner, so err is returned as is.
+func missingSegment(v any, err error) error {
+	switch v.(type) {
+	case encoding.TextUnmarshaler, flag.Value:
+		return err
+	}
+
+	sc, ok := v.(sql.Scanner)
+	if !ok {
+		return err
+	}
+
+	if errors.Is(err, ErrFirstSegNotFound) || errors.Is(err, ErrLastSegNotFound) ||
+		errors.Is(err, ErrKeySegNotFound) {
+		return sc.Scan(nil)
+	}
+
+	return err
+}
+
+// trimAffixes removes the prefix and suffix that are required by c from s. If
+// either is missing, ErrDataUnparsable is returned.
+func trimAffixes(s string, c *config) (string, error) {
+	if !strings.HasPrefix(s, c.prefix) || !strings.HasSuffix(s[len(c.prefix):], c.suffix) {
+		return "", ErrDataUnparsable
+	}
+
+	return s[len(c.prefix) : len(s)-len(c.suffix)], nil
+}
+
+// splitExt splits s into a name and an extension (without its leading dot).
+func splitExt(s string) (string, string) {
+	n := strings.LastIndexByte(s, '.')
+	if n <= 0 || n == len(s)-1 || isDecDigit(s[n+1]) {
+		return s, ""
+	}
+
+	for m := n + 1; m < len(s); m++ {
+		if base62Digit(s[m]) < 0 {
+			return s, ""
+		}
+	}
+
+	return s[:n], s[n+1:]
+}
+
+func segmentToString(path string, i int) (string, error) {
+	j := i + 1
+	if i < 0 && len(path) > 1 && path[len(path)-1] == '/' {
+		path = path[:len(path)-1]
+	}
+
+	s, err := Span(path, i, j)
+	if err != nil {
+		return "", err
+	}
+
+	if s[0] == '/' {
+		s = s[1:]
+	}
+
+	return s, nil
+}
+
+func subSegToString(path, key string, i int, c *config) (string, error) {
+	ki, ks, ok := segIndexByKey(path, key, c)
 	if !ok {
 		return "", ErrKeySegNotFound
 	}
 
-	i++
+	i += ks
 
 	s, err := segmentToString(path[ki:], i)
 	if err != nil {
@@ -162,144 +596,627 @@ func subSegToString(path, key string, i int) (string, error) {
 	return s, nil
 }
 
-func subSegToUintN(path, key string, i, size int) (uint64, error) {
-	ss, err := subSegToString(path, key, i)
+func segmentToPrefix(path string, i int) (netip.Prefix, error) {
+	s, err := segmentToString(path, i)
 	if err != nil {
-		return 0, err
+		return netip.Prefix{}, err
 	}
 
-	s, ok := firstUintFromString(ss)
-	if !ok {
-		return 0, ErrDataUnparsable
+	if strings.IndexByte(s, '%') >= 0 {
+		return stringToPrefix(s)
 	}
 
-	v, err := strconv.ParseUint(s, 10, size)
+	if i == -1 {
+		return netip.Prefix{}, ErrLastSegNotFound
+	}
+
+	bits, err := segmentToString(path, i+1)
 	if err != nil {
-		return 0, ErrDataUnparsable
+		return netip.Prefix{}, ErrLastSegNotFound
 	}
 
-	return v, nil
+	return stringToPrefix(s + "/" + bits)
+}
+
+func segmentToTile(path string, i int) (Tile, error) {
+	j := i + 3
+	if i < 0 && j > 0 {
+		return Tile{}, ErrLastSegNotFound
+	}
+
+	if i < 0 && len(path) > 1 && path[len(path)-1] == '/' {
+		path = path[:len(path)-1]
+	}
+
+	s, err := Span(path, i, j)
+	if err != nil {
+		return Tile{}, err
+	}
+
+	if s[0] == '/' {
+		s = s[1:]
+	}
+
+	return spanToTile(s)
+}
+
+func subSegToTile(path, key string, i int, c *config) (Tile, error) {
+	ki, ks, ok := segIndexByKey(path, key, c)
+	if !ok {
+		return Tile{}, ErrKeySegNotFound
+	}
+
+	return segmentToTile(path[ki:], i+ks)
+}
+
+func subSegToPrefix(path, key string, i int, c *config) (netip.Prefix, error) {
+	ki, ks, ok := segIndexByKey(path, key, c)
+	if !ok {
+		return netip.Prefix{}, ErrKeySegNotFound
+	}
+
+	return segmentToPrefix(path[ki:], i+ks)
+}
+
+// intLiteralBase prepares an integer literal found by one of the int
+// extraction functions for strconv. Prefixed literals are left for strconv to
+// interpret, and underscores are otherwise removed.
+func intLiteralBase(s string, base int) (string, int) {
+	if intPrefixLen(unsigned(s), base) > 0 {
+		return s, 0
+	}
+
+	if base == 0 {
+		base = 10
+	}
+
+	if strings.IndexByte(s, '_') >= 0 {
+		s = strings.ReplaceAll(s, "_", "")
+	}
+
+	return s, base
 }
 
 func firstUintFromString(s string) (string, bool) {
-	ind, l := 0, 0
+	return firstUintFromStringBase(s, 0, false)
+}
 
-	for n := 0; n < len(s); n++ {
-		if unicode.IsDigit(rune(s[n])) {
-			if l == 0 {
-				ind = n
-			}
+func firstUintFromStringBase(s string, base int, lenient bool) (string, bool) {
+	s, ok := firstIntFromStringBase(s, base, lenient)
+	return unsigned(s), ok
+}
+
+func firstIntFromString(s string) (string, bool) {
+	return firstIntFromStringBase(s, 0, false)
+}
+
+func firstIntFromStringBase(s string, base int, lenient bool) (string, bool) {
+	v, _, ok := nextIntFromString(s, 0, base, lenient)
+	return v, ok
+}
+
+// nextIntFromString returns the first int found in s at or after index from,
+// along with the index that follows it.
+func nextIntFromString(s string, from, base int, lenient bool) (string, int, bool) {
+	isDigit := digitFunc(base)
 
-			l++
-		} else {
-			if l == 0 && s[n] == '.' {
-				if n+1 < len(s) && unicode.IsDigit(rune(s[n+1])) {
-					return "0", true
-				}
+	for n := from; n < len(s); n++ {
+		c := s[n]
 
-				break
+		if c == '.' {
+			if n+1 < len(s) && isDigit(s[n+1]) && (n == 0 || !isDigit(s[n-1])) {
+				return "0", n + 1 + intLen(s[n+1:], base), true
 			}
 
-			if l > 0 {
-				break
+			continue
+		}
+
+		m := n
+		if c == '-' || c == '+' && !lenient {
+			if !lenient && wordBefore(s, n) {
+				continue
 			}
+			m++
+		}
+
+		if m == len(s) || !isDigit(s[m]) {
+			continue
 		}
+
+		if base > 10 && wordBefore(s, n) {
+			continue
+		}
+
+		end := m + intLen(s[m:], base)
+		return s[n:end], end, true
 	}
 
-	if l == 0 {
+	return "", len(s), false
+}
+
+// intLen returns the length of the unsigned integer literal at the start of s,
+// which must begin with a digit.
+func intLen(s string, base int) int {
+	p := intPrefixLen(s, base)
+
+	isDigit := digitFunc(base)
+	if p > 0 {
+		isDigit = digitFunc(prefixBase(s[1]))
+	}
+
+	ds := s[p:]
+	n := 0
+
+	for ; n < len(ds); n++ {
+		if isDigit(ds[n]) || ds[n] == '_' && underscoreOK(ds, n, isDigit, p > 0) {
+			continue
+		}
+
+		break
+	}
+
+	return p + n
+}
+
+// intPrefixLen returns the length of the Go-style base prefix at the start of
+// s if it is followed by a valid digit (or an underscore and a valid digit), and
+// the prefix agrees with the base. Otherwise, 0 is returned.
+func intPrefixLen(s string, base int) int {
+	if len(s) < 3 || s[0] != '0' {
+		return 0
+	}
+
+	pb := prefixBase(s[1])
+	if pb == 0 || base != 0 && base != pb {
+		return 0
+	}
+
+	isDigit := digitFunc(pb)
+	if isDigit(s[2]) || s[2] == '_' && len(s) > 3 && isDigit(s[3]) {
+		return 2
+	}
+
+	return 0
+}
+
+func prefixBase(c byte) int {
+	switch c {
+	case 'x', 'X':
+		return 16
+	case 'o', 'O':
+		return 8
+	case 'b', 'B':
+		return 2
+	}
+
+	return 0
+}
+
+func digitFunc(base int) func(byte) bool {
+	if base == 0 || base == 10 {
+		return isDecDigit
+	}
+
+	return func(c byte) bool {
+		var d int
+		switch {
+		case isDecDigit(c):
+			d = int(c - '0')
+		case 'a' <= c && c <= 'z':
+			d = int(c-'a') + 10
+		case 'A' <= c && c <= 'Z':
+			d = int(c-'A') + 10
+		default:
+			return false
+		}
+
+		return d < base
+	}
+}
+
+func unsigned(s string) string {
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		return s[1:]
+	}
+
+	return s
+}
+
+func firstFloatFromString(s string, lenient bool) (string, bool) {
+	v, _, ok := nextFloatFromString(s, 0, lenient)
+	return v, ok
+}
+
+// nextFloatFromString returns the first float found in s at or after index
+// from, along with the index that follows it.
+func nextFloatFromString(s string, from int, lenient bool) (string, int, bool) {
+	for n := from; n < len(s); n++ {
+		if !isFloatStart(s[n]) {
+			continue
+		}
+
+		if !lenient && (s[n] == '-' || s[n] == '+') && wordBefore(s, n) {
+			continue
+		}
+
+		if l := floatLen(s[n:]); l > 0 && floatBoundsOK(s, n, n+l) {
+			return s[n : n+l], n + l, true
+		}
+	}
+
+	return "", len(s), false
+}
+
+// firstComplexFromString returns the first complex number found in s (e.g.
+// "1+2i", "2i", or "1"). A complex number is a float that is either followed
+// by "i", or by a signed float and "i".
+func firstComplexFromString(s string, lenient bool) (string, bool) {
+	re, end, ok := nextFloatFromString(s, 0, lenient)
+	if !ok {
 		return "", false
 	}
+	start := end - len(re)
 
-	return s[ind : ind+l], true
+	if end < len(s) && s[end] == 'i' {
+		return s[start : end+1], true
+	}
+
+	if end < len(s) && (s[end] == '+' || s[end] == '-') {
+		if l := floatLen(s[end:]); l > 1 && end+l < len(s) && s[end+l] == 'i' {
+			return s[start : end+l+1], true
+		}
+	}
+
+	return re, true
 }
 
-func firstIntFromString(s string) (string, bool) { //nolint
-	ind, l := 0, 0
+type nextNumberFunc func(s string, from int) (string, int, bool)
 
-	for n := 0; n < len(s); n++ {
-		if unicode.IsDigit(rune(s[n])) {
-			if l == 0 {
-				ind = n
-			}
+func nextNumberFuncFor(v any, base int, lenient bool) nextNumberFunc {
+	switch v.(type) {
+	case *float32, *float64, *complex64, *complex128, *Decimal, *big.Float, *big.Rat:
+		return func(s string, from int) (string, int, bool) {
+			return nextFloatFromString(s, from, lenient)
+		}
+	}
 
-			l++
-		} else if s[n] == '-' {
-			if l == 0 {
-				ind = n
-				l++
-			} else {
-				break
-			}
-		} else {
-			if l == 0 && s[n] == '.' {
-				if n+1 < len(s) && unicode.IsDigit(rune(s[n+1])) {
-					return "0", true
-				}
+	return func(s string, from int) (string, int, bool) {
+		return nextIntFromString(s, from, base, lenient)
+	}
+}
 
-				break
-			}
+func numbersFromString(s string, next nextNumberFunc) []string {
+	var vs []string
 
-			if l > 0 {
-				break
-			}
+	for n := 0; n < len(s); {
+		v, end, ok := next(s, n)
+		if !ok {
+			break
+		}
+
+		vs = append(vs, v)
+		n = end
+	}
+
+	return vs
+}
+
+// nthNumberFromString returns the number indicated by index nth. If the index
+// is negative, the negative count begins with the last number.
+func nthNumberFromString(s string, nth int, next nextNumberFunc) (string, bool) {
+	if nth < 0 {
+		vs := numbersFromString(s, next)
+		if len(vs)+nth < 0 {
+			return "", false
+		}
+
+		return vs[len(vs)+nth], true
+	}
+
+	for n, ct := 0, 0; n < len(s); ct++ {
+		v, end, ok := next(s, n)
+		if !ok {
+			break
+		}
+
+		if ct == nth {
+			return v, true
 		}
+
+		n = end
+	}
+
+	return "", false
+}
+
+func isFloatStart(c byte) bool {
+	switch c {
+	case '+', '-', '.', 'i', 'I', 'n', 'N':
+		return true
 	}
 
+	return isDecDigit(c)
+}
+
+// floatBoundsOK reports whether the float found at s[i:j] is usable. Plain
+// numbers are always usable, but the words "inf", "infinity", and "nan" must
+// not be part of a larger word (e.g. "info", "banana").
+func floatBoundsOK(s string, i, j int) bool {
+	k := i
+	if s[k] == '+' || s[k] == '-' {
+		k++
+	}
+
+	if k == len(s) || isDecDigit(s[k]) || s[k] == '.' {
+		return true
+	}
+
+	if wordBefore(s, k) {
+		return false
+	}
+
+	return !wordAt(s, j)
+}
+
+// floatLen returns the length of the longest prefix of s that is accepted by
+// strconv.ParseFloat, or 0 if there is none.
+func floatLen(s string) int {
+	n := 0
+	if n < len(s) && (s[n] == '+' || s[n] == '-') {
+		n++
+	}
+
+	if l := specialFloatLen(s[n:], n > 0); l > 0 {
+		return n + l
+	}
+
+	if l := hexFloatLen(s[n:]); l > 0 {
+		return n + l
+	}
+
+	l := mantissaLen(s[n:], isDecDigit, false)
 	if l == 0 {
-		return "", false
+		return 0
 	}
+	n += l
 
-	return s[ind : ind+l], true
+	if n < len(s) && (s[n] == 'e' || s[n] == 'E') {
+		n += exponentLen(s[n:])
+	}
+
+	return n
 }
 
-func firstFloatFromString(s string) (string, bool) { //nolint
-	c, ind, l := 0, 0, 0
+func specialFloatLen(s string, signed bool) int {
+	if hasPrefixFold(s, "infinity") {
+		return len("infinity")
+	}
 
-	for n := 0; n < len(s); n++ {
-		if unicode.IsDigit(rune(s[n])) {
-			if l == 0 {
-				ind = n
-			}
+	if hasPrefixFold(s, "inf") {
+		return len("inf")
+	}
 
-			l++
-		} else if s[n] == '-' {
-			if l == 0 {
-				ind = n
-				l++
-			} else {
-				break
-			}
-		} else if s[n] == '.' {
-			if l == 0 {
-				ind = n
-			}
+	if !signed && hasPrefixFold(s, "nan") {
+		return len("nan")
+	}
 
-			if c > 0 {
-				break
-			}
+	return 0
+}
 
-			l++
-			c++
-		} else if s[n] == 'e' && l > 0 && n+1 < len(s) && s[n+1] == '+' {
-			l++
-		} else if s[n] == '+' && l > 0 && s[n-1] == 'e' {
-			if n+1 < len(s) && unicode.IsDigit(rune(s[n+1])) {
-				l++
-				continue
+func hexFloatLen(s string) int {
+	if len(s) < 2 || s[0] != '0' || (s[1] != 'x' && s[1] != 'X') {
+		return 0
+	}
+
+	l := mantissaLen(s[2:], isHexDigit, true)
+	if l == 0 || 2+l == len(s) {
+		return 0
+	}
+
+	n := 2 + l
+	if s[n] != 'p' && s[n] != 'P' {
+		return 0
+	}
+
+	el := exponentLen(s[n:])
+	if el == 0 {
+		return 0
+	}
+
+	return n + el
+}
+
+// mantissaLen returns the length of the mantissa at the start of s. The
+// mantissa must contain at least one digit, may contain a single decimal point,
+// and may contain underscores that separate digits. For hex mantissas, the
+// base prefix is expected to have been sliced off already, and it counts as a
+// digit in regard to underscores.
+func mantissaLen(s string, isDigit func(byte) bool, prefixed bool) int {
+	n, ds, dot := 0, 0, false
+
+	for ; n < len(s); n++ {
+		c := s[n]
+
+		switch {
+		case isDigit(c):
+			ds++
+
+		case c == '.' && !dot:
+			dot = true
+
+		case c == '_' && underscoreOK(s, n, isDigit, prefixed):
+
+		default:
+			if ds == 0 {
+				return 0
 			}
 
-			l--
+			return n
+		}
+	}
+
+	if ds == 0 {
+		return 0
+	}
+
+	return n
+}
+
+// exponentLen returns the length of the exponent at the start of s (including
+// the exponent marker), or 0 if the exponent is incomplete.
+func exponentLen(s string) int {
+	n := 1
+	if n < len(s) && (s[n] == '+' || s[n] == '-') {
+		n++
+	}
+
+	ds := 0
+	for ; n < len(s); n++ {
+		c := s[n]
+
+		if isDecDigit(c) {
+			ds++
+			continue
+		}
+
+		if c == '_' && underscoreOK(s, n, isDecDigit, false) {
+			continue
+		}
+
+		break
+	}
+
+	if ds == 0 {
+		return 0
+	}
+
+	return n
+}
+
+// underscoreOK reports whether the underscore at s[n] separates digits. A
+// preceding base prefix is treated as a digit.
+func underscoreOK(s string, n int, isDigit func(byte) bool, prefixed bool) bool {
+	prevOK := n == 0 && prefixed || n > 0 && isDigit(s[n-1])
+	return prevOK && n+1 < len(s) && isDigit(s[n+1])
+}
+
+func isDecDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDecDigit(c) || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func isWordChar(c byte) bool {
+	return isDecDigit(c) || c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// isWordRune reports whether r is a letter, digit, or underscore.
+func isWordRune(r rune) bool {
+	if r < utf8.RuneSelf {
+		return isWordChar(byte(r))
+	}
+
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// wordBefore reports whether the rune that ends at index n of s is a word
+// rune.
+func wordBefore(s string, n int) bool {
+	if n <= 0 {
+		return false
+	}
+
+	r, _ := utf8.DecodeLastRuneInString(s[:n])
+	return isWordRune(r)
+}
+
+// wordAt reports whether the rune that begins at index n of s is a word rune.
+func wordAt(s string, n int) bool {
+	if n >= len(s) {
+		return false
+	}
+
+	r, _ := utf8.DecodeRuneInString(s[n:])
+	return isWordRune(r)
+}
+
+// numericString prepares s to be scanned for a number. Invalid UTF-8 results
+// in ErrUTF8Invalid. If c requires it, non-ASCII decimal digits are normalized.
+func numericString(s string, c *config) (string, error) {
+	ascii := true
+	for n := 0; n < len(s); n++ {
+		if s[n] >= utf8.RuneSelf {
+			ascii = false
 			break
-		} else {
-			if l > 0 {
-				break
+		}
+	}
+
+	if ascii {
+		return s, nil
+	}
+
+	if !utf8.ValidString(s) {
+		return "", ErrUTF8Invalid
+	}
+
+	if c.normDigits {
+		s = normalizeDigits(s)
+	}
+
+	return s, nil
+}
+
+// normalizeDigits replaces the decimal digits of any script (e.g. full-width
+// or Arabic-Indic digits) with ASCII digits.
+func normalizeDigits(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for _, r := range s {
+		if r >= utf8.RuneSelf && unicode.IsDigit(r) {
+			// digits are encoded in runs of 0 through 9
+			zero := r
+			for unicode.IsDigit(zero - 1) {
+				zero--
 			}
+
+			r = '0' + (r-zero)%10
 		}
+
+		b.WriteRune(r)
 	}
 
-	if l == 0 || s[ind:ind+l] == "." {
-		return "", false
+	return b.String()
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+// scanSQL unmarshals s into the [sql.Scanner] v. A sql.Null[T] has s
+// unmarshaled into V as any other value would be, and Valid set by the result.
+// Other scanners are provided s as a string.
+func scanSQL(v sql.Scanner, s string, c *config) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() && isSQLNull(rv.Elem().Type()) {
+		rv = rv.Elem()
+		err := unmarshalSegment(rv.FieldByName("V").Addr().Interface(), s, c)
+		if err != ErrUnknownType {
+			rv.FieldByName("Valid").SetBool(err == nil)
+			return err
+		}
 	}
 
-	return s[ind : ind+l], true
+	if err := v.Scan(s); err != nil {
+		return &wrapError{ErrDataUnparsable, err}
+	}
+
+	return nil
+}
+
+// isSQLNull reports whether t is an instance of sql.Null[T].
+func isSQLNull(t reflect.Type) bool {
+	return t.PkgPath() == "database/sql" && strings.HasPrefix(t.Name(), "Null[")
 }
@@ -1,6 +1,10 @@
 package parth
 
-import "testing"
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
 
 func TestUnitFirstFloatFromString(t *testing.T) {
 	tests := []struct {
@@ -22,12 +26,28 @@ func TestUnitFirstFloatFromString(t *testing.T) {
 		{"/3.14e.+12", "3.14", true},
 		{"/3.14e+.13", "3.14", true},
 		{"/3.14e+.13", "3.14", true},
+		{"/1e-3", "1e-3", true},
+		{"/2E5", "2E5", true},
+		{"/+4.5", "+4.5", true},
+		{"/1_000.5", "1_000.5", true},
+		{"/1__0", "1", true},
+		{"/0x1.8p-2", "0x1.8p-2", true},
+		{"/0x1F", "0", true},
+		{"/a-inf", "inf", true},
+		{"x=-inf", "-inf", true},
+		{"a+1.5", "1.5", true},
+		{"(+1.5)", "+1.5", true},
+		{"/Infinity", "Infinity", true},
+		{"/NaN", "NaN", true},
+		{"/info5", "5", true},
+		{"/banana", "", false},
+		{"/--7", "-7", true},
 		{"/error", "", false},
 		{"/.", "", false},
 	}
 
 	for _, tt := range tests {
-		got, okGot := firstFloatFromString(tt.s)
+		got, okGot := firstFloatFromString(tt.s, false)
 		if okGot != tt.okWant {
 			t.Errorf(gwxFmt, tt.s, okGot, tt.okWant)
 			continue
@@ -39,6 +59,35 @@ func TestUnitFirstFloatFromString(t *testing.T) {
 	}
 }
 
+func FuzzFirstFloatFromString(f *testing.F) {
+	seeds := []string{
+		"0.1", "aaaa1.3", ".7.aaaa", "-9", "3.14e+11", "1e-3", "2E5", "+Inf",
+		"infinity", "NaN", "0x1.8p-2", "0x_1p1_0", "1_000", "1e", "0x1F", "--1",
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		got, ok := firstFloatFromString(s, false)
+		if ok {
+			if _, err := strconv.ParseFloat(got, 64); isSyntaxErr(err) {
+				t.Errorf(gwxFmt, s, err, nil)
+			}
+		}
+
+		if _, err := strconv.ParseFloat(s, 64); !isSyntaxErr(err) {
+			if !ok || got != s {
+				t.Errorf(gwxFmt, s, got, s)
+			}
+		}
+	})
+}
+
+func isSyntaxErr(err error) bool {
+	return errors.Is(err, strconv.ErrSyntax)
+}
+
 func TestUnitFirstIntFromString(t *testing.T) {
 	var tests = []struct {
 		s      string
@@ -54,11 +103,21 @@ func TestUnitFirstIntFromString(t *testing.T) {
 		{".7.aaaa", "0", true},
 		{".8aa", "0", true},
 		{"-9", "-9", true},
+		{"+9", "+9", true},
+		{"a-9", "9", true},
+		{"a+9", "9", true},
+		{"x=-9", "-9", true},
 		{"10-", "10", true},
 		{"3.14e+11", "3", true},
 		{"3.14e.+12", "3", true},
 		{"3.14e+.13", "3", true},
 		{"18446744073709551615", "18446744073709551615", true},
+		{"0x1F", "0x1F", true},
+		{"-0o17", "-0o17", true},
+		{"0b_101", "0b_101", true},
+		{"0b2", "0", true},
+		{"1_000_", "1_000", true},
+		{"a-b5", "5", true},
 		{".", "", false},
 		{"error", "", false},
 	}
@@ -112,3 +171,157 @@ func TestUnitFirstUintFromString(t *testing.T) {
 		}
 	}
 }
+
+func TestUnitFirstIntFromStringBase(t *testing.T) {
+	var tests = []struct {
+		s      string
+		base   int
+		want   string
+		okWant bool
+	}{
+		{"ff", 16, "ff", true},
+		{"reg-1F", 16, "1F", true},
+		{"regff", 16, "", false},
+		{"0x1F", 16, "0x1F", true},
+		{"0b101", 16, "0b101", true},
+		{"0b101", 2, "0b101", true},
+		{"29", 8, "2", true},
+		{"z9", 36, "z9", true},
+	}
+
+	for _, tt := range tests {
+		got, okGot := firstIntFromStringBase(tt.s, tt.base, false)
+		if okGot != tt.okWant {
+			t.Errorf(gwxFmt, tt.s, okGot, tt.okWant)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestUnitNthNumberFromString(t *testing.T) {
+	ints := nextNumberFuncFor(nil, 0, false)
+	lenient := nextNumberFuncFor(nil, 0, true)
+	floats := nextNumberFuncFor(new(float64), 0, false)
+
+	var tests = []struct {
+		s      string
+		nth    int
+		next   nextNumberFunc
+		want   string
+		okWant bool
+	}{
+		{"v2.14.3", 0, ints, "2", true},
+		{"v2.14.3", 1, ints, "14", true},
+		{"v2.14.3", 2, ints, "3", true},
+		{"v2.14.3", -1, ints, "3", true},
+		{"v2.14.3", -3, ints, "2", true},
+		{"v2.14.3", 3, ints, "", false},
+		{"v2.14.3", -4, ints, "", false},
+		{"800x600", 1, ints, "600", true},
+		{".5x-2", 0, ints, "0", true},
+		{".5x-2", 1, ints, "2", true},
+		{".5x-2", 1, lenient, "-2", true},
+		{"(-2,+3)", 1, ints, "+3", true},
+		{"(-2,+3)", 1, lenient, "3", true},
+		{"a_-2", 0, ints, "2", true},
+		{"1.5x2.5", 1, floats, "2.5", true},
+		{"1.5x2.5", -2, floats, "1.5", true},
+		{"none", 0, ints, "", false},
+	}
+
+	for _, tt := range tests {
+		got, okGot := nthNumberFromString(tt.s, tt.nth, tt.next)
+		if okGot != tt.okWant {
+			t.Errorf(gwxFmt, tt.s, okGot, tt.okWant)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestUnitFirstComplexFromString(t *testing.T) {
+	var tests = []struct {
+		s      string
+		want   string
+		okWant bool
+	}{
+		{"1+2i", "1+2i", true},
+		{"(1.5-2e3i)", "1.5-2e3i", true},
+		{"z3i", "3i", true},
+		{"4", "4", true},
+		{"5+i", "5", true},
+		{"6+7", "6", true},
+		{"-inf+nani", "-inf", true},
+		{"error", "", false},
+	}
+
+	for _, tt := range tests {
+		got, okGot := firstComplexFromString(tt.s, false)
+		if okGot != tt.okWant {
+			t.Errorf(gwxFmt, tt.s, okGot, tt.okWant)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestUnitSplitExt(t *testing.T) {
+	var tests = []struct {
+		s, name, ext string
+	}{
+		{"42.json", "42", "json"},
+		{"report.tar.gz", "report.tar", "gz"},
+		{"photo.mp4", "photo", "mp4"},
+		{"3.5", "3.5", ""},
+		{".hidden", ".hidden", ""},
+		{"trailing.", "trailing.", ""},
+		{"v1.2-rc", "v1.2-rc", ""},
+		{"plain", "plain", ""},
+	}
+
+	for _, tt := range tests {
+		name, ext := splitExt(tt.s)
+		if name != tt.name || ext != tt.ext {
+			t.Errorf(gwxFmt, tt.s, name+" "+ext, tt.name+" "+tt.ext)
+		}
+	}
+}
+
+func TestUnitNumericString(t *testing.T) {
+	var tests = []struct {
+		s    string
+		norm bool
+		want string
+		err  error
+	}{
+		{"id42", false, "id42", nil},
+		{"ｉｄ４２", false, "ｉｄ４２", nil},
+		{"ｉｄ４２", true, "ｉｄ42", nil},
+		{"٤٢", true, "42", nil},
+		{"𝟗𝟠", true, "98", nil},
+		{"id\xff42", false, "", ErrUTF8Invalid},
+		{"id\xff42", true, "", ErrUTF8Invalid},
+	}
+
+	for _, tt := range tests {
+		got, err := numericString(tt.s, &config{normDigits: tt.norm})
+		if !errors.Is(err, tt.err) {
+			t.Errorf(gwxFmt, tt.s, err, tt.err)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.s, got, tt.want)
+		}
+	}
+}
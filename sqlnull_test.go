@@ -0,0 +1,56 @@
+//go:build go1.22
+
+package parth
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestBhvrSQLNullGeneric(t *testing.T) {
+	path := "/active/true/since/2020-01-02/name/ann"
+
+	t.Run("present", func(t *testing.T) {
+		var active sql.Null[bool]
+		var since sql.Null[time.Time]
+
+		p := New(path)
+		p.Sequent(&active, "active")
+		p.Sequent(&since, "since")
+		if unx(t, t.Name(), p.Err()) {
+			return
+		}
+
+		if want := (sql.Null[bool]{V: true, Valid: true}); active != want {
+			t.Errorf(gwFmt, active, want)
+		}
+
+		want := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+		if !since.V.Equal(want) || !since.Valid {
+			t.Errorf(gwFmt, since, sql.Null[time.Time]{V: want, Valid: true})
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		since := sql.Null[time.Time]{V: time.Now(), Valid: true}
+		if err := Sequent(&since, path, "until"); unx(t, t.Name(), err) {
+			return
+		}
+
+		if since.Valid {
+			t.Errorf(gwFmt, since, sql.Null[time.Time]{})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		active := sql.Null[bool]{V: true, Valid: true}
+		if exp(t, t.Name(), Sequent(&active, path, "name")) {
+			return
+		}
+
+		if active.Valid {
+			t.Errorf(gwFmt, active, sql.Null[bool]{})
+		}
+	})
+}
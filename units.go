@@ -0,0 +1,219 @@
+package parth
+
+import (
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// ByteSize is a number of bytes. It can be unmarshaled from a number that is
+// followed by an SI unit (e.g. "10MB", where 1MB is 1000^2 bytes) or an IEC
+// unit (e.g. "1.5GiB", where 1GiB is 1024^3 bytes). Units are matched
+// case-insensitively, and a number with no unit (or the unit "B") is a count
+// of bytes. A size that does not fit within a ByteSize results in
+// [ErrOutOfRange].
+type ByteSize uint64
+
+// ByteSize units.
+const (
+	Byte ByteSize = 1
+
+	KB ByteSize = 1000 * Byte
+	MB ByteSize = 1000 * KB
+	GB ByteSize = 1000 * MB
+	TB ByteSize = 1000 * GB
+	PB ByteSize = 1000 * TB
+	EB ByteSize = 1000 * PB
+
+	KiB ByteSize = 1 << (10 * (iota - 6))
+	MiB
+	GiB
+	TiB
+	PiB
+	EiB
+)
+
+var byteSizeUnits = map[string]uint64{
+	"":    uint64(Byte),
+	"b":   uint64(Byte),
+	"kb":  uint64(KB),
+	"mb":  uint64(MB),
+	"gb":  uint64(GB),
+	"tb":  uint64(TB),
+	"pb":  uint64(PB),
+	"eb":  uint64(EB),
+	"kib": uint64(KiB),
+	"mib": uint64(MiB),
+	"gib": uint64(GiB),
+	"tib": uint64(TiB),
+	"pib": uint64(PiB),
+	"eib": uint64(EiB),
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *ByteSize) UnmarshalText(text []byte) error {
+	v, neg, err := parseUnitNumber(strings.ToLower(string(text)), byteSizeUnits)
+	if err != nil {
+		return err
+	}
+
+	if neg && v > 0 {
+		return ErrDataUnparsable
+	}
+
+	*b = ByteSize(v)
+
+	return nil
+}
+
+// String returns the size in the largest IEC unit that it is at least one of
+// (e.g. "1.5GiB"), or as a count of bytes (e.g. "512B").
+func (b ByteSize) String() string {
+	units := []struct {
+		name string
+		size ByteSize
+	}{
+		{"EiB", EiB}, {"PiB", PiB}, {"TiB", TiB}, {"GiB", GiB}, {"MiB", MiB}, {"KiB", KiB},
+	}
+
+	for _, u := range units {
+		if b >= u.size {
+			return strconv.FormatFloat(float64(b)/float64(u.size), 'f', -1, 64) + u.name
+		}
+	}
+
+	return strconv.FormatUint(uint64(b), 10) + "B"
+}
+
+// parseUnitNumber parses a decimal number (with an optional sign and fraction)
+// that is directly followed by one of the keys of units. The magnitude of the
+// number multiplied by the unit is returned along with whether the number is
+// negative. Any fraction that remains after multiplication is truncated. If
+// the magnitude overflows a uint64, whether the number is negative is still
+// returned along with ErrOutOfRange.
+func parseUnitNumber(s string, units map[string]uint64) (uint64, bool, error) {
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+
+	var v, frac uint64
+	scale := uint64(1)
+	overflow := false
+	n, ds := 0, 0
+
+	for ; n < len(s) && isDecDigit(s[n]); n++ {
+		ds++
+
+		hi, lo := bits.Mul64(v, 10)
+		lo, carry := bits.Add64(lo, uint64(s[n]-'0'), 0)
+		if hi > 0 || carry > 0 {
+			overflow = true
+		}
+		v = lo
+	}
+
+	if n < len(s) && s[n] == '.' {
+		n++
+
+		for ; n < len(s) && isDecDigit(s[n]); n++ {
+			ds++
+			if scale > (1<<64-1)/10 {
+				continue
+			}
+			frac = frac*10 + uint64(s[n]-'0')
+			scale *= 10
+		}
+	}
+
+	if ds == 0 {
+		return 0, false, ErrDataUnparsable
+	}
+
+	unit, ok := units[s[n:]]
+	if !ok {
+		return 0, false, ErrDataUnparsable
+	}
+
+	hi, lo := bits.Mul64(v, unit)
+	if overflow || hi > 0 {
+		return 0, neg, ErrOutOfRange
+	}
+
+	// frac < scale, so the quotient is always less than unit
+	fhi, flo := bits.Mul64(frac, unit)
+	q, _ := bits.Div64(fhi, flo, scale)
+
+	lo, carry := bits.Add64(lo, q, 0)
+	if carry > 0 {
+		return 0, neg, ErrOutOfRange
+	}
+
+	return lo, neg, nil
+}
+
+// unitsToIntN converts a segment that holds a number followed by a unit into
+// an int of the provided size. A size of 0 means int, as with
+// [strconv.ParseInt].
+func unitsToIntN(s string, size int, c *config) (int64, error) {
+	v, neg, err := parseUnitNumber(s, c.units)
+	if err == ErrOutOfRange && c.saturate {
+		v, err = 1<<64-1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if size == 0 {
+		size = strconv.IntSize
+	}
+
+	max := uint64(1)<<(size-1) - 1
+	if neg {
+		max++
+	}
+
+	if v > max {
+		if !c.saturate {
+			return 0, ErrOutOfRange
+		}
+		v = max
+	}
+
+	if neg && v > 0 {
+		return -int64(v-1) - 1, nil
+	}
+
+	return int64(v), nil
+}
+
+// unitsToUintN converts a segment that holds a number followed by a unit into
+// a uint of the provided size. A size of 0 means uint, as with
+// [strconv.ParseUint].
+func unitsToUintN(s string, size int, c *config) (uint64, error) {
+	v, neg, err := parseUnitNumber(s, c.units)
+	if err == ErrOutOfRange && c.saturate {
+		v, err = 1<<64-1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if neg && v > 0 {
+		return 0, ErrDataUnparsable
+	}
+
+	if size == 0 {
+		size = strconv.IntSize
+	}
+
+	if max := uint64(1)<<(size-1)<<1 - 1; v > max {
+		if !c.saturate {
+			return 0, ErrOutOfRange
+		}
+		v = max
+	}
+
+	return v, nil
+}
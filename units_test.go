@@ -0,0 +1,125 @@
+package parth
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestUnitByteSize(t *testing.T) {
+	tests := []struct {
+		s    string
+		want ByteSize
+		err  error
+	}{
+		{"512", 512, nil},
+		{"512B", 512, nil},
+		{"10MB", 10 * MB, nil},
+		{"10mb", 10 * MB, nil},
+		{"1.5GiB", 3 * GiB / 2, nil},
+		{"1.5kib", 1536, nil},
+		{"0.0001KB", 0, nil},
+		{"-0B", 0, nil},
+		{"16EiB", 0, ErrOutOfRange},
+		{"18446744073709551615", 1<<64 - 1, nil},
+		{"18446744073709551616", 0, ErrOutOfRange},
+		{"-1KB", 0, ErrDataUnparsable},
+		{"10XB", 0, ErrDataUnparsable},
+		{"GB", 0, ErrDataUnparsable},
+		{"", 0, ErrDataUnparsable},
+	}
+
+	for _, tt := range tests {
+		var got ByteSize
+		err := got.UnmarshalText([]byte(tt.s))
+		if !errors.Is(err, tt.err) {
+			t.Errorf(gwxFmt, tt.s, err, tt.err)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.s, got, tt.want)
+		}
+	}
+
+	strs := map[ByteSize]string{
+		512:         "512B",
+		KiB:         "1KiB",
+		3 * GiB / 2: "1.5GiB",
+		EiB:         "1EiB",
+	}
+
+	for b, want := range strs {
+		if got := b.String(); got != want {
+			t.Errorf(gwxFmt, uint64(b), got, want)
+		}
+	}
+}
+
+func TestBhvrUnits(t *testing.T) {
+	path := "/quota/10GB/timeout/1.5k/offset/-2k/max/300"
+	units := map[string]uint64{"k": 1000, "M": 1000000}
+
+	var size ByteSize
+	if err := Sequent(&size, path, "quota"); unx(t, "quota", err) {
+		return
+	}
+
+	if size != 10*GB {
+		t.Errorf(gwFmt, size, 10*GB)
+	}
+
+	tests := []struct {
+		name string
+		key  string
+		opts []Option
+		want int64
+		ck   checkFunc
+	}{
+		{"fraction", "timeout", []Option{Units(units)}, 1500, unx},
+		{"negative", "offset", []Option{Units(units)}, -2000, unx},
+		{"no unit", "max", []Option{Units(units)}, 0, exp},
+		{"empty unit", "max", []Option{Units(map[string]uint64{"": 1})}, 300, unx},
+		{"overflow", "max", []Option{Units(map[string]uint64{"": 1 << 62})}, 0, exp},
+		{"saturate", "max", []Option{Units(map[string]uint64{"": 1 << 62}), Saturate()}, 1<<63 - 1, unx},
+	}
+
+	for _, tt := range tests {
+		var got int64
+		err := Sequent(&got, path, tt.key, tt.opts...)
+		if tt.ck(t, tt.name, err) {
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.name, got, tt.want)
+		}
+	}
+
+	var u uint8
+	err := Sequent(&u, path, "timeout", Units(units))
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Errorf(gwFmt, err, ErrOutOfRange)
+	}
+
+	var n int
+	if err := Sequent(&n, path, "offset", Units(units)); !unx(t, "int", err) && n != -2000 {
+		t.Errorf(gwFmt, n, -2000)
+	}
+
+	huge := Units(map[string]uint64{"": 1 << 62})
+	if err := Sequent(&n, path, "max", huge, Saturate()); !unx(t, "int saturate", err) && n != math.MaxInt {
+		t.Errorf(gwFmt, n, math.MaxInt)
+	}
+
+	var un uint
+	if err := Sequent(&un, path, "timeout", Units(units)); !unx(t, "uint", err) && un != 1500 {
+		t.Errorf(gwFmt, un, 1500)
+	}
+
+	exp(t, "uint overflow", Sequent(&un, path, "max", huge))
+
+	if err := Sequent(&un, path, "max", huge, Saturate()); !unx(t, "uint saturate", err) && un != math.MaxUint {
+		t.Errorf(gwFmt, un, uint(math.MaxUint))
+	}
+}
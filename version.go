@@ -0,0 +1,311 @@
+package parth
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Version is a semantic version. It can be unmarshaled from a full SemVer 2.0
+// version (e.g. "1.4.2-rc.1+build.5"), or from a version that is made up of
+// only a major version, or a major and minor version (e.g. "1", "1.2"). An
+// optional leading "v" or "V" is permitted (e.g. "v1.2"). Parts that are not
+// provided are zero.
+type Version struct {
+	Major uint64
+	Minor uint64
+	Patch uint64
+	Pre   string // prerelease identifiers (e.g. "rc.1")
+	Build string // build metadata (e.g. "build.5")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Version) UnmarshalText(text []byte) error {
+	x, n := parseVersion(string(text))
+	if n == 0 || n != len(text) {
+		return ErrDataUnparsable
+	}
+
+	*v = x
+
+	return nil
+}
+
+// String returns the full SemVer form of the Version (e.g. "1.2.0").
+func (v Version) String() string {
+	s := strconv.FormatUint(v.Major, 10) + "." + strconv.FormatUint(v.Minor, 10) +
+		"." + strconv.FormatUint(v.Patch, 10)
+
+	if v.Pre != "" {
+		s += "-" + v.Pre
+	}
+
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+
+	return s
+}
+
+// Compare returns an integer comparing the precedence of two versions. The
+// result will be 0 if v == w, -1 if v < w, and +1 if v > w. As described by
+// SemVer, build metadata does not affect precedence.
+func (v Version) Compare(w Version) int {
+	if c := compareUint(v.Major, w.Major); c != 0 {
+		return c
+	}
+
+	if c := compareUint(v.Minor, w.Minor); c != 0 {
+		return c
+	}
+
+	if c := compareUint(v.Patch, w.Patch); c != 0 {
+		return c
+	}
+
+	return comparePre(v.Pre, w.Pre)
+}
+
+// Less reports whether v has a lower precedence than w.
+func (v Version) Less(w Version) bool {
+	return v.Compare(w) < 0
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+func comparePre(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+
+	for {
+		var x, y string
+		x, a, _ = strings.Cut(a, ".")
+		y, b, _ = strings.Cut(b, ".")
+
+		if c := compareIdent(x, y); c != 0 {
+			return c
+		}
+
+		switch {
+		case a == "" && b == "":
+			return 0
+		case a == "":
+			return -1
+		case b == "":
+			return 1
+		}
+	}
+}
+
+// compareIdent compares prerelease identifiers. Numeric identifiers have a
+// lower precedence than others, and are compared numerically.
+func compareIdent(x, y string) int {
+	xNum, yNum := isNumericIdent(x), isNumericIdent(y)
+
+	switch {
+	case xNum && yNum:
+		if c := compareUint(uint64(len(x)), uint64(len(y))); c != 0 {
+			return c
+		}
+	case xNum:
+		return -1
+	case yNum:
+		return 1
+	}
+
+	return strings.Compare(x, y)
+}
+
+func isNumericIdent(s string) bool {
+	for n := 0; n < len(s); n++ {
+		if !isDecDigit(s[n]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseVersion parses the version found at the start of s, and returns it
+// along with its length. A length of 0 indicates that no version was found.
+func parseVersion(s string) (Version, int) {
+	var v Version
+	n := 0
+
+	if s != "" && (s[0] == 'v' || s[0] == 'V') {
+		n++
+	}
+
+	parts := [3]*uint64{&v.Major, &v.Minor, &v.Patch}
+	for k, part := range parts {
+		if k > 0 {
+			if n+1 >= len(s) || s[n] != '.' || !isDecDigit(s[n+1]) {
+				return v, n
+			}
+			n++
+		}
+
+		l := versionNumLen(s[n:])
+		if l == 0 {
+			if k == 0 {
+				return Version{}, 0
+			}
+
+			return v, n - 1
+		}
+
+		var err error
+		if *part, err = strconv.ParseUint(s[n:n+l], 10, 64); err != nil {
+			return Version{}, 0
+		}
+		n += l
+	}
+
+	if n < len(s) && s[n] == '-' {
+		if l := identsLen(s[n+1:], true); l > 0 {
+			v.Pre = s[n+1 : n+1+l]
+			n += 1 + l
+		}
+	}
+
+	if n < len(s) && s[n] == '+' {
+		if l := identsLen(s[n+1:], false); l > 0 {
+			v.Build = s[n+1 : n+1+l]
+			n += 1 + l
+		}
+	}
+
+	return v, n
+}
+
+// versionNumLen returns the length of the version number at the start of s.
+// Leading zeros are not permitted.
+func versionNumLen(s string) int {
+	n := 0
+	for n < len(s) && isDecDigit(s[n]) {
+		n++
+	}
+
+	if n > 1 && s[0] == '0' {
+		return 0
+	}
+
+	return n
+}
+
+// identsLen returns the length of the dot-separated identifiers at the start
+// of s. If numeric is true, numeric identifiers must not hold leading zeros.
+func identsLen(s string, numeric bool) int {
+	n := 0
+
+	for {
+		l := 0
+		for n+l < len(s) && (base62Digit(s[n+l]) >= 0 || s[n+l] == '-') {
+			l++
+		}
+
+		if l == 0 {
+			if n == 0 {
+				return 0
+			}
+
+			return n - 1
+		}
+
+		if ident := s[n : n+l]; numeric && l > 1 && ident[0] == '0' && isNumericIdent(ident) {
+			return 0
+		}
+		n += l
+
+		if n+1 >= len(s) || s[n] != '.' {
+			return n
+		}
+		n++
+	}
+}
+
+// versionBound is a single comparison of a version constraint (e.g. ">=1.2").
+type versionBound struct {
+	op string
+	v  Version
+}
+
+// parseVersionConstraint parses space-separated comparisons, all of which
+// must be satisfied by a version. Valid operators are =, !=, <, <=, >, and >=.
+func parseVersionConstraint(s string) ([]versionBound, bool) {
+	var bs []versionBound
+
+	for _, f := range strings.Fields(s) {
+		var b versionBound
+
+		for _, op := range []string{">=", "<=", "!=", ">", "<", "="} {
+			if strings.HasPrefix(f, op) {
+				b.op, f = op, f[len(op):]
+				break
+			}
+		}
+
+		v, n := parseVersion(f)
+		if b.op == "" || n == 0 || n != len(f) {
+			return nil, false
+		}
+		b.v = v
+
+		bs = append(bs, b)
+	}
+
+	return bs, len(bs) > 0
+}
+
+// versionMatches reports whether v satisfies all of bs. A prerelease version
+// only matches when a bound holds a prerelease of the same major, minor, and
+// patch version (e.g. "1.2.0-rc.2" matches ">=1.2.0-rc.1", but "2.0.0-rc.1"
+// does not match "<2").
+func versionMatches(v Version, bs []versionBound) bool {
+	preOK := v.Pre == ""
+
+	for _, b := range bs {
+		if b.v.Pre != "" && b.v.Major == v.Major && b.v.Minor == v.Minor &&
+			b.v.Patch == v.Patch {
+			preOK = true
+		}
+
+		c := v.Compare(b.v)
+
+		var ok bool
+		switch b.op {
+		case "=":
+			ok = c == 0
+		case "!=":
+			ok = c != 0
+		case "<":
+			ok = c < 0
+		case "<=":
+			ok = c <= 0
+		case ">":
+			ok = c > 0
+		case ">=":
+			ok = c >= 0
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return preOK
+}
@@ -0,0 +1,74 @@
+package parth
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUnitVersion(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Version
+		err  error
+	}{
+		{"v1", Version{Major: 1}, nil},
+		{"V1.2", Version{Major: 1, Minor: 2}, nil},
+		{"1.4.2", Version{Major: 1, Minor: 4, Patch: 2}, nil},
+		{"1.4.2-rc1", Version{Major: 1, Minor: 4, Patch: 2, Pre: "rc1"}, nil},
+		{"1.0.0-alpha.1+001.sha-5114f85", Version{Major: 1, Pre: "alpha.1", Build: "001.sha-5114f85"}, nil},
+		{"1.0.0+build", Version{Major: 1, Build: "build"}, nil},
+		{"01.2", Version{}, ErrDataUnparsable},
+		{"1.02", Version{}, ErrDataUnparsable},
+		{"1.2-rc1", Version{}, ErrDataUnparsable},
+		{"1.0.0-01", Version{}, ErrDataUnparsable},
+		{"1.0.0-rc.", Version{}, ErrDataUnparsable},
+		{"1.0.0+", Version{}, ErrDataUnparsable},
+		{"1.2.3.4", Version{}, ErrDataUnparsable},
+		{"v", Version{}, ErrDataUnparsable},
+		{"99999999999999999999", Version{}, ErrDataUnparsable},
+	}
+
+	for _, tt := range tests {
+		var got Version
+		err := got.UnmarshalText([]byte(tt.s))
+		if !errors.Is(err, tt.err) {
+			t.Errorf(gwxFmt, tt.s, err, tt.err)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf(gwxFmt, tt.s, got, tt.want)
+		}
+	}
+
+	if got, want := (Version{Major: 1, Pre: "rc.1", Build: "b5"}).String(), "1.0.0-rc.1+b5"; got != want {
+		t.Errorf(gwFmt, got, want)
+	}
+}
+
+func TestUnitVersionCompare(t *testing.T) {
+	// in order of precedence, as listed by SemVer 2.0
+	vs := []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta",
+		"1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.2.0", "1.10.0",
+		"2",
+	}
+
+	for n := 0; n < len(vs); n++ {
+		for m := 0; m < len(vs); m++ {
+			var v, w Version
+			_ = v.UnmarshalText([]byte(vs[n]))
+			_ = w.UnmarshalText([]byte(vs[m]))
+
+			want := compareUint(uint64(n), uint64(m))
+			if got := v.Compare(w); got != want {
+				t.Errorf(gwxFmt, vs[n]+" vs "+vs[m], got, want)
+			}
+		}
+	}
+
+	a, b := Version{Major: 1, Build: "a"}, Version{Major: 1, Build: "b"}
+	if a.Compare(b) != 0 || a.Less(b) {
+		t.Errorf(gwFmt, a.Compare(b), 0)
+	}
+}